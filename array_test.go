@@ -0,0 +1,48 @@
+package valid
+
+import "testing"
+
+const arraySchema = `{
+	"type": "array",
+	"items": {
+		"type": "object",
+		"properties": {
+			"email": {
+				"type": "string",
+				"format": "email",
+				"errorMessage": {"format": "e-mail inválido"},
+				"errorCode": "contact.email"
+			}
+		},
+		"required": ["email"],
+		"errorMessage": {"required": {"email": "e-mail é obrigatório"}}
+	}
+}`
+
+func TestArrayItemErrorsKeepIndex(t *testing.T) {
+	validator, err := NewFromString(arraySchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`[{"email": "a@x.com"}, {"email": "b@x.com"}, {"email": "invalido"}, {}]`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 2 {
+		t.Fatalf("esperava 2 erros, recebeu %+v", result.Errors)
+	}
+
+	formatErr := result.Errors[0]
+	if formatErr.Field != "2.email" || fieldPointer(formatErr.Field) != "/2/email" {
+		t.Errorf("esperava o índice do terceiro elemento no campo, recebeu '%s'", formatErr.Field)
+	}
+	if formatErr.Message != "e-mail inválido" || formatErr.Code != "contact.email" {
+		t.Errorf("esperava mensagem e código personalizados do item, recebeu %+v", formatErr)
+	}
+
+	requiredErr := result.Errors[1]
+	if requiredErr.Field != "3" || requiredErr.Message != "e-mail é obrigatório" {
+		t.Errorf("esperava erro required no quarto elemento com mensagem personalizada, recebeu %+v", requiredErr)
+	}
+}
@@ -0,0 +1,182 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// compositionKeywordByType maps the gojsonschema error types of composition failures to their keyword
+var compositionKeywordByType = map[string]string{
+	"number_one_of": "oneOf",
+	"number_any_of": "anyOf",
+}
+
+// selectBestMatch replaces, for every oneOf/anyOf failure, the branch errors
+// reported by gojsonschema with the errors of the closest branch: the one with
+// the fewest const/enum violations (a mismatched discriminator means the client
+// was not aiming at that branch), then the fewest errors overall. The composition
+// error is kept as a summary naming the selected branch.
+func (v *Validator) selectBestMatch(document interface{}, result *ValidationResult) {
+	for _, failure := range append([]ValidationError(nil), result.Errors...) {
+		keyword, ok := compositionKeywordByType[failure.Constraint]
+		if !ok {
+			continue
+		}
+
+		value, schemas, found := v.locate(document, failure.Field)
+		if !found {
+			continue
+		}
+
+		for _, schema := range schemas {
+			branches, ok := schema[keyword].([]interface{})
+			if !ok {
+				continue
+			}
+
+			index, allErrors, ok := v.evaluateBranches(branches, value)
+			if !ok {
+				continue
+			}
+
+			result.Errors = replaceBranchErrors(result.Errors, failure, keyword, index, allErrors)
+			break
+		}
+	}
+}
+
+// locate returns the value at a dotted field path of the document and the schemas that apply to it
+func (v *Validator) locate(document interface{}, field string) (interface{}, []map[string]interface{}, bool) {
+	var (
+		value   interface{}
+		schemas []map[string]interface{}
+		found   bool
+	)
+
+	v.walkDocument(document, func(path string, current interface{}, currentSchemas []map[string]interface{}) {
+		if !found && path == field {
+			value, schemas, found = current, currentSchemas, true
+		}
+	})
+
+	return value, schemas, found
+}
+
+// evaluateBranches validates value against each branch and returns the index of
+// the closest one along with the errors of every branch
+func (v *Validator) evaluateBranches(branches []interface{}, value interface{}) (int, [][]ValidationError, bool) {
+	normalized, ok, err := normalizeGoValue(value)
+	if !ok || err != nil {
+		return 0, nil, false
+	}
+
+	bestIndex := -1
+	bestDiscriminators := 0
+	allErrors := make([][]ValidationError, len(branches))
+
+	for i, branch := range branches {
+		branchValidator, err := v.branchValidator(branch)
+		if err != nil {
+			return 0, nil, false
+		}
+
+		branchResult, err := branchValidator.runSchema(gojsonschema.NewRawLoader(normalized))
+		if err != nil {
+			return 0, nil, false
+		}
+
+		var errs []ValidationError
+		for _, branchErr := range branchValidator.buildValidationResult(branchResult).Errors {
+			// The allOf wrapping the branch only repeats its failures
+			if branchErr.Field == "" && branchErr.Constraint == "number_all_of" {
+				continue
+			}
+			errs = append(errs, branchErr)
+		}
+		allErrors[i] = errs
+
+		discriminators := 0
+		for _, branchErr := range errs {
+			if branchErr.Constraint == "const" || branchErr.Constraint == "enum" {
+				discriminators++
+			}
+		}
+
+		if bestIndex == -1 || discriminators < bestDiscriminators ||
+			(discriminators == bestDiscriminators && len(errs) < len(allErrors[bestIndex])) {
+			bestIndex, bestDiscriminators = i, discriminators
+		}
+	}
+
+	return bestIndex, allErrors, bestIndex >= 0
+}
+
+// branchValidator returns a cached validator for a single composition branch.
+// The definitions of the root schema are kept so local references still resolve.
+func (v *Validator) branchValidator(branch interface{}) (*Validator, error) {
+	branchBytes, err := json.Marshal(branch)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao serializar alternativa do schema: %w", err)
+	}
+
+	validator, _, err := v.derivedValidator("branch:"+string(branchBytes), func(schema map[string]interface{}) interface{} {
+		var branchCopy interface{}
+		json.Unmarshal(branchBytes, &branchCopy)
+
+		for key := range schema {
+			if key != "definitions" && key != "$defs" {
+				delete(schema, key)
+			}
+		}
+		schema["allOf"] = []interface{}{branchCopy}
+		return nil
+	})
+	return validator, err
+}
+
+// replaceBranchErrors removes the errors that came from the branch gojsonschema
+// picked, recognized as errors some branch produces for the same field and
+// constraint, and adds those of the selected branch
+func replaceBranchErrors(errs []ValidationError, failure ValidationError, keyword string, index int, allErrors [][]ValidationError) []ValidationError {
+	fromBranches := make(map[string]bool)
+	for _, branchErrors := range allErrors {
+		for _, branchErr := range branchErrors {
+			fromBranches[branchFieldPath(failure.Field, branchErr.Field)+"|"+branchErr.Constraint] = true
+		}
+	}
+
+	replaced := make([]ValidationError, 0, len(errs)+len(allErrors[index]))
+
+	for _, validationErr := range errs {
+		if validationErr.Field == failure.Field && validationErr.Constraint == failure.Constraint {
+			validationErr.Message = fmt.Sprintf("não corresponde ao %s; alternativa mais próxima: %d", keyword, index)
+			replaced = append(replaced, validationErr)
+			continue
+		}
+		if fromBranches[validationErr.Field+"|"+validationErr.Constraint] {
+			continue
+		}
+		replaced = append(replaced, validationErr)
+	}
+
+	for _, branchErr := range allErrors[index] {
+		branchErr.Field = branchFieldPath(failure.Field, branchErr.Field)
+		branchErr.Context = "(root)"
+		if branchErr.Field != "" {
+			branchErr.Context += "." + branchErr.Field
+		}
+		replaced = append(replaced, branchErr)
+	}
+
+	return replaced
+}
+
+// branchFieldPath places a field reported by a branch under the failed field
+func branchFieldPath(path, field string) string {
+	if field == "" {
+		return path
+	}
+	return joinFieldPath(path, field)
+}
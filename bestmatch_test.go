@@ -0,0 +1,76 @@
+package valid
+
+import "testing"
+
+const unionSchema = `{
+	"type": "object",
+	"properties": {
+		"pet": {
+			"oneOf": [
+				{"$ref": "#/definitions/Cat"},
+				{"$ref": "#/definitions/Dog"}
+			]
+		}
+	},
+	"definitions": {
+		"Cat": {
+			"type": "object",
+			"properties": {
+				"kind": {"const": "cat"},
+				"lives": {"type": "integer", "maximum": 9},
+				"indoor": {"type": "boolean"}
+			},
+			"required": ["kind", "lives", "indoor"]
+		},
+		"Dog": {
+			"type": "object",
+			"properties": {
+				"kind": {"const": "dog"},
+				"bark": {"type": "string", "minLength": 3}
+			},
+			"required": ["kind", "bark"]
+		}
+	}
+}`
+
+func TestBestMatchOneOf(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(unionSchema), Options{BestMatchOneOf: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	// The discriminator points to Dog even though it has more violations than Cat
+	result, err := validator.ValidateString(`{"pet": {"kind": "dog", "lives": 3, "indoor": true}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Fatal("esperava dados inválidos")
+	}
+
+	var summary *ValidationError
+	fields := make(map[string]string)
+	for i, validationErr := range result.Errors {
+		if validationErr.Constraint == "number_one_of" {
+			summary = &result.Errors[i]
+			continue
+		}
+		fields[validationErr.Field] = validationErr.Constraint
+	}
+
+	if summary == nil || summary.Field != "pet" {
+		t.Fatalf("esperava erro resumo de oneOf em 'pet', recebeu %+v", result.Errors)
+	}
+	if fields["pet"] != "required" {
+		t.Errorf("esperava erro required do ramo Dog, recebeu %+v", result.Errors)
+	}
+	if _, ok := fields["pet.kind"]; ok {
+		t.Errorf("não esperava erro de const do ramo Cat, recebeu %+v", result.Errors)
+	}
+
+	// Valid branch
+	result, err = validator.ValidateString(`{"pet": {"kind": "cat", "lives": 7, "indoor": false}}`)
+	if err != nil || !result.Valid {
+		t.Errorf("esperava dados válidos, recebeu %+v, %v", result, err)
+	}
+}
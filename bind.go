@@ -0,0 +1,56 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// BindAndValidate validates the request body with v and decodes it into a T,
+// collapsing the "validate then decode" preamble of a handler into one call:
+//
+//	user, ok := valid.BindAndValidate[User](w, r, validator)
+//	if !ok {
+//		return
+//	}
+//
+// When the body is invalid, the error handler set with SetDefaultErrorHandler,
+// or the standard one, writes the response and ok is false, as it is when the
+// body can not be read or decoded into T. The body is validated whatever the
+// request method, and stays readable after the call. With the Normalize option,
+// T is decoded from the normalized document, which is the one validated.
+func BindAndValidate[T any](w http.ResponseWriter, r *http.Request, v *Validator) (T, bool) {
+	var value T
+	bound := false
+
+	config := v.middlewareConfig(MiddlewareConfig{})
+	validation, err := v.validateMiddlewareRequest(r.Context(), r, config)
+	if err != nil {
+		if !requestCanceled(r, err) {
+			http.Error(w, fmt.Sprintf("Erro interno de validação: %s", err.Error()),
+				http.StatusInternalServerError)
+		}
+		return value, false
+	}
+
+	handleValidation(w, r, config, validation, func(w http.ResponseWriter, r *http.Request) {
+		// With the Normalize option, decode the document that was validated
+		body := validation.Normalized
+		var err error
+		if len(body) == 0 {
+			body, err = readRequestBody(r)
+			body = v.active().documentBytes(body)
+		}
+		if err == nil {
+			err = json.Unmarshal(body, &value)
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Erro ao decodificar corpo da requisição: %s", err.Error()),
+				http.StatusBadRequest)
+			return
+		}
+		bound = true
+	})
+
+	return value, bound
+}
@@ -0,0 +1,98 @@
+package valid
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBindAndValidate(t *testing.T) {
+	type user struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+		Age   int    `json:"age"`
+	}
+
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "Ana", "email": "ana@x.com", "age": 30}`))
+	bound, ok := BindAndValidate[user](w, req, validator)
+	if !ok {
+		t.Fatalf("esperava corpo válido, recebeu status %d: %s", w.Code, w.Body.String())
+	}
+	if bound != (user{Name: "Ana", Email: "ana@x.com", Age: 30}) {
+		t.Errorf("valor decodificado inesperado: %+v", bound)
+	}
+	if body, _ := io.ReadAll(req.Body); !strings.Contains(string(body), "ana@x.com") {
+		t.Errorf("o corpo deveria continuar legível, recebeu '%s'", body)
+	}
+
+	// Invalid bodies get the standard error response
+	w = httptest.NewRecorder()
+	bound, ok = BindAndValidate[user](w, httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "A"}`)), validator)
+	if ok || bound != (user{}) {
+		t.Fatalf("esperava falha com valor zero, recebeu %+v", bound)
+	}
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("esperava status 400, recebeu %d", w.Code)
+	}
+	var response ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil || len(response.Details) == 0 {
+		t.Errorf("esperava resposta de erro padrão, recebeu %s", w.Body.String())
+	}
+
+	// The handler set on the validator is reused
+	validator.SetDefaultErrorHandler(func(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+	w = httptest.NewRecorder()
+	if _, ok := BindAndValidate[user](w, httptest.NewRequest("PUT", "/users", strings.NewReader(`{}`)), validator); ok || w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("esperava o handler do validator com status 422, recebeu ok=%v status %d", ok, w.Code)
+	}
+
+	// Valid documents that do not fit T are rejected
+	permissive, err := NewFromString(`{"type": "object"}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	w = httptest.NewRecorder()
+	if _, ok := BindAndValidate[user](w, httptest.NewRequest("POST", "/users", strings.NewReader(`{"age": "trinta"}`)), permissive); ok || w.Code != http.StatusBadRequest {
+		t.Errorf("esperava erro de decodificação com status 400, recebeu ok=%v status %d", ok, w.Code)
+	}
+}
+
+func TestBindAndValidateNormalized(t *testing.T) {
+	type contact struct {
+		Email string `json:"email"`
+	}
+
+	schema := `{
+		"type": "object",
+		"properties": {
+			"email": {"type": "string", "pattern": "^[a-z@.]+$", "x-trim": true, "x-lowercase": true}
+		},
+		"required": ["email"]
+	}`
+
+	validator, err := NewFromBytesWithOptions([]byte(schema), Options{Normalize: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/contacts", strings.NewReader(`{"email": " Joao@Example.com "}`))
+	bound, ok := BindAndValidate[contact](w, req, validator)
+	if !ok {
+		t.Fatalf("esperava corpo válido, recebeu status %d: %s", w.Code, w.Body.String())
+	}
+	if bound.Email != "joao@example.com" {
+		t.Errorf("esperava o e-mail normalizado, recebeu '%s'", bound.Email)
+	}
+}
@@ -0,0 +1,58 @@
+package valid
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// countingReader counts the bytes read from a request body. The count is
+// atomic as a timed out validation may still be reading it.
+type countingReader struct {
+	io.ReadCloser
+	n atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.n.Add(int64(n))
+	return n, err
+}
+
+// limitRequestBody applies MaxBodyBytes to the request body and, when a
+// BodyReadHook is set, starts counting the bytes read from it. The returned
+// function reports the count to the hook and is safe to call when neither is set.
+func limitRequestBody(w http.ResponseWriter, r *http.Request, config MiddlewareConfig) func() {
+	if r.Body == nil || (config.MaxBodyBytes <= 0 && config.BodyReadHook == nil) {
+		return func() {}
+	}
+
+	body := r.Body
+	if config.MaxBodyBytes > 0 {
+		body = http.MaxBytesReader(w, body, config.MaxBodyBytes)
+	}
+
+	counter := &countingReader{ReadCloser: body}
+	r.Body = counter
+
+	return func() {
+		if config.BodyReadHook != nil {
+			config.BodyReadHook(counter.n.Load())
+		}
+	}
+}
+
+// bodyTooLarge writes the response for a body over MaxBodyBytes and reports
+// whether err is that case
+func bodyTooLarge(w http.ResponseWriter, err error) bool {
+	var maxErr *http.MaxBytesError
+	if !errors.As(err, &maxErr) {
+		return false
+	}
+
+	http.Error(w, fmt.Sprintf("Corpo da requisição excede o limite de %d bytes", maxErr.Limit),
+		http.StatusRequestEntityTooLarge)
+	return true
+}
@@ -0,0 +1,56 @@
+package valid
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareBodyLimitAndHook(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	var sizes []int64
+	var received string
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		MaxBodyBytes: 64,
+		BodyReadHook: func(n int64) { sizes = append(sizes, n) },
+	}, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	valid := `{"name": "Ana", "email": "ana@x.com"}`
+	w := httptest.NewRecorder()
+	middleware(w, httptest.NewRequest("POST", "/test", strings.NewReader(valid)))
+	if w.Code != http.StatusOK {
+		t.Errorf("esperava status 200, recebeu %d", w.Code)
+	}
+	if received != valid {
+		t.Errorf("o handler deveria receber o corpo original, recebeu '%s'", received)
+	}
+
+	// The hook is called on validation failure too
+	invalid := `{"name": "A"}`
+	w = httptest.NewRecorder()
+	middleware(w, httptest.NewRequest("POST", "/test", strings.NewReader(invalid)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("esperava status 400, recebeu %d", w.Code)
+	}
+
+	oversized := `{"name": "` + strings.Repeat("a", 100) + `"}`
+	w = httptest.NewRecorder()
+	middleware(w, httptest.NewRequest("POST", "/test", strings.NewReader(oversized)))
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("esperava status 413, recebeu %d", w.Code)
+	}
+
+	if len(sizes) != 3 || sizes[0] != int64(len(valid)) || sizes[1] != int64(len(invalid)) || sizes[2] > 64 {
+		t.Errorf("tamanhos inesperados reportados ao hook: %v", sizes)
+	}
+}
@@ -0,0 +1,22 @@
+package valid
+
+import "bytes"
+
+// utf8BOM is the byte order mark some Windows tools write at the start of UTF-8 files
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// stripBOM removes a leading UTF-8 BOM, which encoding/json and gojsonschema
+// reject as an invalid character. The rest of the bytes, including leading
+// whitespace, is already valid JSON and is returned unchanged.
+func stripBOM(data []byte) []byte {
+	return bytes.TrimPrefix(data, utf8BOM)
+}
+
+// documentBytes returns the bytes of a document as validated, without its BOM
+// unless the RejectBOM option is set
+func (v *Validator) documentBytes(data []byte) []byte {
+	if v.opts.RejectBOM {
+		return data
+	}
+	return stripBOM(data)
+}
@@ -0,0 +1,49 @@
+package valid
+
+import "testing"
+
+func TestByteOrderMark(t *testing.T) {
+	schema := append([]byte("\xEF\xBB\xBF\r\n"), testSchema...)
+	document := []byte("\xEF\xBB\xBF  \n{\"name\": \"Ana\", \"email\": \"ana@x.com\"}")
+
+	validator, err := NewFromBytes(schema)
+	if err != nil {
+		t.Fatalf("não esperava erro com schema precedido de BOM, mas recebeu: %v", err)
+	}
+
+	checks := map[string]func() (*ValidationResult, error){
+		"ValidateBytes":  func() (*ValidationResult, error) { return validator.ValidateBytes(document) },
+		"ValidateString": func() (*ValidationResult, error) { return validator.ValidateString(string(document)) },
+		"PooledValidate": func() (*ValidationResult, error) { return validator.PooledValidate(document) },
+	}
+	for name, check := range checks {
+		result, err := check()
+		if err != nil {
+			t.Fatalf("%s: não esperava erro, mas recebeu: %v", name, err)
+		}
+		if !result.Valid {
+			t.Errorf("%s: esperava documento precedido de BOM válido, recebeu %+v", name, result.Errors)
+		}
+	}
+
+	// A document holding only the BOM is empty
+	if _, err := validator.ValidateBytes([]byte("\xEF\xBB\xBF")); err == nil {
+		t.Error("esperava erro para documento contendo apenas o BOM")
+	}
+
+	// RejectBOM keeps the strict behavior
+	if _, err := NewFromBytesWithOptions(schema, Options{RejectBOM: true}); err == nil {
+		t.Error("esperava erro com schema precedido de BOM e RejectBOM")
+	}
+	strict, err := NewFromBytesWithOptions([]byte(testSchema), Options{RejectBOM: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err := strict.ValidateBytes(document)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != codeInvalidJSON {
+		t.Errorf("esperava erro de JSON inválido com RejectBOM, recebeu %+v", result.Errors)
+	}
+}
@@ -0,0 +1,129 @@
+package valid
+
+// codePrefix namespaces the machine-readable error codes
+const codePrefix = "validation."
+
+// constraintKeywordByType maps gojsonschema error types to the JSON Schema keyword that produced them
+var constraintKeywordByType = map[string]string{
+	"false":                           "false",
+	"required":                        "required",
+	"invalid_type":                    "type",
+	"number_any_of":                   "anyOf",
+	"number_one_of":                   "oneOf",
+	"number_all_of":                   "allOf",
+	"number_not":                      "not",
+	"missing_dependency":              "dependencies",
+	"internal":                        "internal",
+	"const":                           "const",
+	"enum":                            "enum",
+	"array_no_additional_items":       "additionalItems",
+	"array_min_items":                 "minItems",
+	"array_max_items":                 "maxItems",
+	"unique":                          "uniqueItems",
+	"contains":                        "contains",
+	"array_min_properties":            "minProperties",
+	"array_max_properties":            "maxProperties",
+	"additional_property_not_allowed": "additionalProperties",
+	"invalid_property_pattern":        "patternProperties",
+	"invalid_property_name":           "propertyNames",
+	"string_gte":                      "minLength",
+	"string_lte":                      "maxLength",
+	"pattern":                         "pattern",
+	"format":                          "format",
+	"multiple_of":                     "multipleOf",
+	"number_gte":                      "minimum",
+	"number_gt":                       "exclusiveMinimum",
+	"number_lte":                      "maximum",
+	"number_lt":                       "exclusiveMaximum",
+	"condition_then":                  "then",
+	"condition_else":                  "else",
+}
+
+// codeInvalidJSON is the code reported when the document is not valid JSON
+const codeInvalidJSON = codePrefix + "invalidJson"
+
+// constraintKeyword returns the JSON Schema keyword for a gojsonschema error type
+func constraintKeyword(errorType string) string {
+	if keyword, ok := constraintKeywordByType[errorType]; ok {
+		return keyword
+	}
+	return errorType
+}
+
+// errorCode returns the stable code for a constraint violation on field, honoring
+// codes declared in the schema through the errorCode keyword
+func (v *Validator) errorCode(field, errorType string) string {
+	baseField := v.schemaFieldKey(field)
+
+	if fieldCodes, ok := v.customCodes[baseField]; ok {
+		if code, ok := fieldCodes[errorType]; ok {
+			return code
+		}
+		if code, ok := fieldCodes[constraintKeyword(errorType)]; ok {
+			return code
+		}
+		if code, ok := fieldCodes["_"]; ok {
+			return code
+		}
+	}
+
+	return codePrefix + constraintKeyword(errorType)
+}
+
+// extractErrorCodes extracts custom error codes from the properties of the schema.
+// The errorCode keyword may be a string, applied to every constraint of the field,
+// or an object keyed by constraint.
+func extractErrorCodes(schema map[string]interface{}) map[string]map[string]string {
+	errorCodes := make(map[string]map[string]string)
+
+	for _, props := range schemaPropertyMaps(schema) {
+		for field, prop := range props {
+			propMap, ok := prop.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch code := propMap["errorCode"].(type) {
+			case string:
+				errorCodes[field] = map[string]string{"_": code}
+			case map[string]interface{}:
+				fieldCodes := make(map[string]string)
+				for key, value := range code {
+					if codeStr, ok := value.(string); ok {
+						fieldCodes[key] = codeStr
+					}
+				}
+				errorCodes[field] = fieldCodes
+			}
+		}
+	}
+
+	return errorCodes
+}
+
+// schemaPropertyMaps returns the properties maps of an object schema and of the
+// items of an array schema, including those declared in allOf branches, which
+// apply to the same document (e.g. the fragments combined by NewFromMerged)
+func schemaPropertyMaps(schema map[string]interface{}) []map[string]interface{} {
+	var maps []map[string]interface{}
+
+	if props, ok := schema["properties"].(map[string]interface{}); ok {
+		maps = append(maps, props)
+	}
+
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		if props, ok := items["properties"].(map[string]interface{}); ok {
+			maps = append(maps, props)
+		}
+	}
+
+	if branches, ok := schema["allOf"].([]interface{}); ok {
+		for _, branch := range branches {
+			if branchMap, ok := branch.(map[string]interface{}); ok {
+				maps = append(maps, schemaPropertyMaps(branchMap)...)
+			}
+		}
+	}
+
+	return maps
+}
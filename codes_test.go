@@ -0,0 +1,89 @@
+package valid
+
+import "testing"
+
+func TestConstraintKeywordMapping(t *testing.T) {
+	mapping := map[string]string{
+		"required":                        "validation.required",
+		"invalid_type":                    "validation.type",
+		"number_any_of":                   "validation.anyOf",
+		"number_one_of":                   "validation.oneOf",
+		"number_all_of":                   "validation.allOf",
+		"number_not":                      "validation.not",
+		"missing_dependency":              "validation.dependencies",
+		"const":                           "validation.const",
+		"enum":                            "validation.enum",
+		"array_no_additional_items":       "validation.additionalItems",
+		"array_min_items":                 "validation.minItems",
+		"array_max_items":                 "validation.maxItems",
+		"unique":                          "validation.uniqueItems",
+		"contains":                        "validation.contains",
+		"array_min_properties":            "validation.minProperties",
+		"array_max_properties":            "validation.maxProperties",
+		"additional_property_not_allowed": "validation.additionalProperties",
+		"invalid_property_pattern":        "validation.patternProperties",
+		"invalid_property_name":           "validation.propertyNames",
+		"string_gte":                      "validation.minLength",
+		"string_lte":                      "validation.maxLength",
+		"pattern":                         "validation.pattern",
+		"format":                          "validation.format",
+		"multiple_of":                     "validation.multipleOf",
+		"number_gte":                      "validation.minimum",
+		"number_gt":                       "validation.exclusiveMinimum",
+		"number_lte":                      "validation.maximum",
+		"number_lt":                       "validation.exclusiveMaximum",
+		"condition_then":                  "validation.then",
+		"condition_else":                  "validation.else",
+	}
+
+	validator := &Validator{}
+	for errorType, expected := range mapping {
+		if code := validator.errorCode("field", errorType); code != expected {
+			t.Errorf("tipo '%s': esperava código '%s', recebeu '%s'", errorType, expected, code)
+		}
+	}
+}
+
+func TestValidationErrorCode(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 2},
+			"email": {"type": "string", "format": "email", "errorCode": "user.email.invalid"},
+			"age": {"type": "integer", "minimum": 0, "errorCode": {"minimum": "user.age.negative"}}
+		},
+		"required": ["name"]
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "J", "email": "invalido", "age": -1}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	codes := make(map[string]string)
+	for _, validationErr := range result.Errors {
+		codes[validationErr.Field] = validationErr.Code
+	}
+
+	expected := map[string]string{
+		"name":  "validation.minLength",
+		"email": "user.email.invalid",
+		"age":   "user.age.negative",
+	}
+	for field, code := range expected {
+		if codes[field] != code {
+			t.Errorf("campo '%s': esperava código '%s', recebeu '%s'", field, code, codes[field])
+		}
+	}
+
+	result, err = validator.ValidateString(`{"name": "Ana"`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Errors[0].Code != "validation.invalidJson" {
+		t.Errorf("esperava código 'validation.invalidJson', recebeu '%s'", result.Errors[0].Code)
+	}
+}
@@ -0,0 +1,127 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// transformDocument rewrites the string values of the document before
+// validation: normalized by the x-trim/x-lowercase/x-uppercase extensions when
+// normalize is set, then converted to the number, integer or boolean type
+// expected by their schemas when coerce is set. Documents that do not decode
+// are returned unchanged, so the usual error is reported.
+func (v *Validator) transformDocument(jsonData []byte, normalize, coerce bool) []byte {
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.UseNumber()
+
+	var document interface{}
+	if err := dec.Decode(&document); err != nil {
+		return jsonData
+	}
+
+	root := v.schemaObj
+	transform := func(schemas []map[string]interface{}, str string) (interface{}, bool) {
+		changed := false
+		if normalize {
+			str, changed = normalizeString(schemas, str)
+		}
+		if coerce {
+			if coerced, ok := coerceString(schemas, str); ok {
+				return coerced, true
+			}
+		}
+		return str, changed
+	}
+
+	transformed, changed := transformNode(root, expandSchema(root, root), document, transform)
+	if !changed {
+		return jsonData
+	}
+
+	encoded, err := json.Marshal(transformed)
+	if err != nil {
+		return jsonData
+	}
+	return encoded
+}
+
+// transformNode returns the value with its strings, and those nested in it,
+// rewritten by transform, reporting whether anything changed
+func transformNode(root map[string]interface{}, schemas []map[string]interface{}, value interface{}, transform func(schemas []map[string]interface{}, str string) (interface{}, bool)) (interface{}, bool) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		changed := false
+		for key, child := range typed {
+			transformed, childChanged := transformNode(root, propertySchemas(root, schemas, key), child, transform)
+			if childChanged {
+				typed[key] = transformed
+				changed = true
+			}
+		}
+		return typed, changed
+	case []interface{}:
+		changed := false
+		for i, child := range typed {
+			transformed, childChanged := transformNode(root, itemSchemas(root, schemas, i), child, transform)
+			if childChanged {
+				typed[i] = transformed
+				changed = true
+			}
+		}
+		return typed, changed
+	case string:
+		return transform(schemas, typed)
+	default:
+		return value, false
+	}
+}
+
+// coerceString converts a string to the first type its schemas accept, unless
+// they already accept strings
+func coerceString(schemas []map[string]interface{}, str string) (interface{}, bool) {
+	types := schemaTypes(schemas)
+	if len(types) == 0 || types["string"] {
+		return str, false
+	}
+
+	if (types["integer"] || types["number"]) && isJSONNumber(str) {
+		if _, err := strconv.ParseInt(str, 10, 64); err == nil || types["number"] {
+			return json.Number(str), true
+		}
+	}
+	if types["boolean"] {
+		switch str {
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+	}
+
+	return str, false
+}
+
+// isJSONNumber reports whether str is a number literal as written in JSON
+func isJSONNumber(str string) bool {
+	var number json.Number
+	return json.Unmarshal([]byte(str), &number) == nil
+}
+
+// schemaTypes returns the types declared by the type keyword of the schemas
+func schemaTypes(schemas []map[string]interface{}) map[string]bool {
+	types := make(map[string]bool)
+	for _, schema := range schemas {
+		switch typed := schema["type"].(type) {
+		case string:
+			types[typed] = true
+		case []interface{}:
+			for _, item := range typed {
+				if name, ok := item.(string); ok {
+					types[name] = true
+				}
+			}
+		}
+	}
+	return types
+}
@@ -0,0 +1,207 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// lowerBoundKeywords are the constraints that become stricter when their value grows
+var lowerBoundKeywords = map[string]bool{
+	"minimum": true, "exclusiveMinimum": true, "minLength": true, "minItems": true, "minProperties": true,
+}
+
+// upperBoundKeywords are the constraints that become stricter when their value shrinks
+var upperBoundKeywords = map[string]bool{
+	"maximum": true, "exclusiveMaximum": true, "maxLength": true, "maxItems": true, "maxProperties": true,
+}
+
+// IsBackwardCompatible reports whether every payload accepted by the old schema
+// is still accepted by the new one, along with the reasons when it is not:
+// new required fields, narrowed types and enums, stricter bounds, new patterns
+// or formats, and properties removed from objects that reject additional
+// properties. Changes to keywords whose effect can not be compared, such as
+// allOf or oneOf, are reported as breaking.
+func IsBackwardCompatible(oldBytes, newBytes []byte) (bool, []string, error) {
+	differ, err := diffSchemas(oldBytes, newBytes)
+	if err != nil {
+		return false, nil, err
+	}
+	diff := differ.diff
+
+	var reasons []string
+
+	for _, field := range diff.AddedRequired {
+		reasons = append(reasons, fmt.Sprintf("o campo '%s' passou a ser obrigatório", field))
+	}
+
+	for _, field := range differ.closedRemovals {
+		reasons = append(reasons, fmt.Sprintf("a propriedade '%s' foi removida e o objeto não aceita propriedades adicionais", field))
+	}
+
+	for _, change := range diff.ChangedTypes {
+		if narrowsTypes(change.Old, change.New) {
+			reasons = append(reasons, fmt.Sprintf("o tipo de '%s' foi restringido de %s para %s",
+				displayField(change.Path), typeDescription(change.Old), typeDescription(change.New)))
+		}
+	}
+
+	for _, change := range diff.ChangedConstraints {
+		if reason, breaking := constraintBreak(change); breaking {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return len(reasons) == 0, reasons, nil
+}
+
+// narrowsTypes reports whether a value of some old type is no longer accepted.
+// An integer is still accepted where a number is.
+func narrowsTypes(oldTypes, newTypes []string) bool {
+	if len(newTypes) == 0 {
+		return false
+	}
+	if len(oldTypes) == 0 {
+		return true
+	}
+
+	accepted := make(map[string]bool, len(newTypes))
+	for _, name := range newTypes {
+		accepted[name] = true
+	}
+
+	for _, name := range oldTypes {
+		if !accepted[name] && !(name == "integer" && accepted["number"]) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeDescription describes a type list for a breaking reason
+func typeDescription(types []string) string {
+	if len(types) == 0 {
+		return "qualquer tipo"
+	}
+	return strings.Join(types, "|")
+}
+
+// constraintBreak reports whether a constraint change may reject a payload the
+// old schema accepted, and why
+func constraintBreak(change ConstraintChange) (string, bool) {
+	field := displayField(change.Path)
+
+	if change.New == nil {
+		// Removing a constraint only accepts more payloads
+		return "", false
+	}
+
+	switch {
+	case lowerBoundKeywords[change.Keyword] || upperBoundKeywords[change.Keyword]:
+		return boundBreak(change, field)
+	case change.Keyword == "enum":
+		return enumBreak(change, field)
+	case change.Keyword == "uniqueItems":
+		if unique, _ := change.New.(bool); !unique {
+			return "", false
+		}
+	case change.Keyword == "additionalProperties":
+		if allowed, ok := change.New.(bool); ok && allowed {
+			return "", false
+		}
+	}
+
+	if change.Old == nil {
+		return fmt.Sprintf("%s foi adicionado em '%s': %s", change.Keyword, field, schemaValueText(change.New)), true
+	}
+	return fmt.Sprintf("%s de '%s' foi alterado de %s para %s", change.Keyword, field,
+		schemaValueText(change.Old), schemaValueText(change.New)), true
+}
+
+// boundBreak checks a numeric bound, which is stricter when added, when a lower
+// bound grows or when an upper bound shrinks. The boolean exclusiveMinimum and
+// exclusiveMaximum of draft 4 are stricter when they become true.
+func boundBreak(change ConstraintChange, field string) (string, bool) {
+	if exclusive, ok := change.New.(bool); ok {
+		if oldExclusive, _ := change.Old.(bool); exclusive && !oldExclusive {
+			return fmt.Sprintf("%s de '%s' passou a ser exclusivo", change.Keyword, field), true
+		}
+		return "", false
+	}
+
+	newValue, ok := schemaNumber(change.New)
+	if !ok {
+		return "", false
+	}
+
+	oldValue, ok := schemaNumber(change.Old)
+	if !ok {
+		return fmt.Sprintf("%s foi adicionado em '%s': %s", change.Keyword, field, schemaValueText(change.New)), true
+	}
+
+	if (lowerBoundKeywords[change.Keyword] && newValue > oldValue) ||
+		(upperBoundKeywords[change.Keyword] && newValue < oldValue) {
+		return fmt.Sprintf("%s de '%s' ficou mais restritivo: %s -> %s", change.Keyword, field,
+			schemaValueText(change.Old), schemaValueText(change.New)), true
+	}
+	return "", false
+}
+
+// enumBreak checks an enum, which is narrowed when added or when it drops values
+func enumBreak(change ConstraintChange, field string) (string, bool) {
+	newValues, _ := change.New.([]interface{})
+
+	oldValues, ok := change.Old.([]interface{})
+	if !ok {
+		return fmt.Sprintf("enum foi adicionado em '%s': %s", field, schemaValueText(change.New)), true
+	}
+
+	var dropped []interface{}
+	for _, oldValue := range oldValues {
+		kept := false
+		for _, newValue := range newValues {
+			if enumMemberEqual(oldValue, newValue) {
+				kept = true
+				break
+			}
+		}
+		if !kept {
+			dropped = append(dropped, oldValue)
+		}
+	}
+
+	if len(dropped) == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("enum de '%s' deixou de aceitar %s", field, schemaValueText(dropped)), true
+}
+
+// enumMemberEqual reports whether two enum members accept the same instance.
+// Numbers are compared by value, so 1 and 1.0 are the same member.
+func enumMemberEqual(a, b interface{}) bool {
+	if x, ok := schemaNumber(a); ok {
+		y, ok := schemaNumber(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// schemaNumber returns the value of a numeric schema keyword
+func schemaNumber(value interface{}) (float64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := number.Float64()
+	return f, err == nil
+}
+
+// schemaValueText renders a schema keyword value as JSON
+func schemaValueText(value interface{}) string {
+	text, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(text)
+}
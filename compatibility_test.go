@@ -0,0 +1,165 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsBackwardCompatible(t *testing.T) {
+	tests := []struct {
+		name         string
+		oldSchema    string
+		newSchema    string
+		expectCompat bool
+		expectReason string
+	}{
+		{
+			name:         "optional property added",
+			oldSchema:    `{"type": "object", "properties": {"name": {"type": "string"}}}`,
+			newSchema:    `{"type": "object", "properties": {"name": {"type": "string"}, "email": {"type": "string"}}}`,
+			expectCompat: true,
+		},
+		{
+			name:         "new required field",
+			oldSchema:    `{"type": "object", "properties": {"name": {"type": "string"}}}`,
+			newSchema:    `{"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}`,
+			expectReason: "o campo 'name' passou a ser obrigatório",
+		},
+		{
+			name:         "required field dropped",
+			oldSchema:    `{"type": "object", "required": ["name"]}`,
+			newSchema:    `{"type": "object"}`,
+			expectCompat: true,
+		},
+		{
+			name:         "narrowed enum",
+			oldSchema:    `{"properties": {"status": {"enum": ["active", "inactive", "pending"]}}}`,
+			newSchema:    `{"properties": {"status": {"enum": ["active", "inactive"]}}}`,
+			expectReason: `enum de 'status' deixou de aceitar ["pending"]`,
+		},
+		{
+			name:         "widened enum",
+			oldSchema:    `{"properties": {"status": {"enum": ["active"]}}}`,
+			newSchema:    `{"properties": {"status": {"enum": ["active", "inactive"]}}}`,
+			expectCompat: true,
+		},
+		{
+			name:         "enum number reformatted",
+			oldSchema:    `{"properties": {"level": {"enum": [1, 2, "x"]}}}`,
+			newSchema:    `{"properties": {"level": {"enum": [1.0, 2e0, "x"]}}}`,
+			expectCompat: true,
+		},
+		{
+			name:         "enum number dropped",
+			oldSchema:    `{"properties": {"level": {"enum": [1, 2]}}}`,
+			newSchema:    `{"properties": {"level": {"enum": [1.0, "2"]}}}`,
+			expectReason: "enum de 'level' deixou de aceitar [2]",
+		},
+		{
+			name:         "enum added",
+			oldSchema:    `{"properties": {"status": {"type": "string"}}}`,
+			newSchema:    `{"properties": {"status": {"type": "string", "enum": ["active"]}}}`,
+			expectReason: `enum foi adicionado em 'status'`,
+		},
+		{
+			name:         "stricter minimum",
+			oldSchema:    `{"properties": {"age": {"type": "integer", "minimum": 0}}}`,
+			newSchema:    `{"properties": {"age": {"type": "integer", "minimum": 18}}}`,
+			expectReason: "minimum de 'age' ficou mais restritivo: 0 -> 18",
+		},
+		{
+			name:         "stricter maxLength",
+			oldSchema:    `{"properties": {"name": {"type": "string", "maxLength": 50}}}`,
+			newSchema:    `{"properties": {"name": {"type": "string", "maxLength": 30}}}`,
+			expectReason: "maxLength de 'name' ficou mais restritivo: 50 -> 30",
+		},
+		{
+			name:         "maximum added",
+			oldSchema:    `{"properties": {"age": {"type": "integer"}}}`,
+			newSchema:    `{"properties": {"age": {"type": "integer", "maximum": 120}}}`,
+			expectReason: "maximum foi adicionado em 'age': 120",
+		},
+		{
+			name:         "relaxed bounds",
+			oldSchema:    `{"properties": {"age": {"type": "integer", "minimum": 18, "maximum": 100}}}`,
+			newSchema:    `{"properties": {"age": {"type": "integer", "minimum": 0}}}`,
+			expectCompat: true,
+		},
+		{
+			name:         "draft 4 exclusive minimum",
+			oldSchema:    `{"properties": {"age": {"type": "integer", "minimum": 0}}}`,
+			newSchema:    `{"properties": {"age": {"type": "integer", "minimum": 0, "exclusiveMinimum": true}}}`,
+			expectReason: "exclusiveMinimum de 'age' passou a ser exclusivo",
+		},
+		{
+			name:         "removed property with additionalProperties false",
+			oldSchema:    `{"type": "object", "properties": {"name": {"type": "string"}, "nickname": {"type": "string"}}, "additionalProperties": false}`,
+			newSchema:    `{"type": "object", "properties": {"name": {"type": "string"}}, "additionalProperties": false}`,
+			expectReason: "a propriedade 'nickname' foi removida e o objeto não aceita propriedades adicionais",
+		},
+		{
+			name:         "removed property with open object",
+			oldSchema:    `{"type": "object", "properties": {"name": {"type": "string"}, "nickname": {"type": "string"}}}`,
+			newSchema:    `{"type": "object", "properties": {"name": {"type": "string"}}}`,
+			expectCompat: true,
+		},
+		{
+			name:         "object closed",
+			oldSchema:    `{"type": "object", "properties": {"name": {"type": "string"}}}`,
+			newSchema:    `{"type": "object", "properties": {"name": {"type": "string"}}, "additionalProperties": false}`,
+			expectReason: "additionalProperties foi adicionado em '(root)': false",
+		},
+		{
+			name:         "narrowed type",
+			oldSchema:    `{"properties": {"id": {"type": ["string", "integer"]}}}`,
+			newSchema:    `{"properties": {"id": {"type": "string"}}}`,
+			expectReason: "o tipo de 'id' foi restringido de integer|string para string",
+		},
+		{
+			name:         "integer widened to number",
+			oldSchema:    `{"properties": {"price": {"type": "integer"}}}`,
+			newSchema:    `{"properties": {"price": {"type": "number"}}}`,
+			expectCompat: true,
+		},
+		{
+			name:         "pattern added to array items",
+			oldSchema:    `{"properties": {"tags": {"type": "array", "items": {"type": "string"}}}}`,
+			newSchema:    `{"properties": {"tags": {"type": "array", "items": {"type": "string", "pattern": "^[a-z]+$"}}}}`,
+			expectReason: "pattern foi adicionado em 'tags[]'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compatible, reasons, err := IsBackwardCompatible([]byte(tt.oldSchema), []byte(tt.newSchema))
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if compatible != tt.expectCompat {
+				t.Errorf("esperava compatível=%v, recebeu %v (%v)", tt.expectCompat, compatible, reasons)
+			}
+			if tt.expectCompat && len(reasons) != 0 {
+				t.Errorf("não esperava motivos, recebeu %v", reasons)
+			}
+			if tt.expectReason == "" {
+				return
+			}
+
+			found := false
+			for _, reason := range reasons {
+				if strings.Contains(reason, tt.expectReason) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("esperava motivo contendo '%s', recebeu %v", tt.expectReason, reasons)
+			}
+		})
+	}
+}
+
+func TestIsBackwardCompatibleInvalidSchema(t *testing.T) {
+	if _, _, err := IsBackwardCompatible([]byte(`{`), []byte(`{}`)); err == nil {
+		t.Error("esperava erro para schema inválido")
+	}
+}
@@ -0,0 +1,44 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// constExpected returns the value required by the const keyword that err
+// violated, decoded from the JSON text gojsonschema reports as allowed
+func constExpected(err gojsonschema.ResultError) (interface{}, bool) {
+	if err.Type() != "const" {
+		return nil, false
+	}
+
+	allowed, ok := err.Details()["allowed"].(string)
+	if !ok {
+		return nil, false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(allowed))
+	decoder.UseNumber()
+
+	var expected interface{}
+	if decoder.Decode(&expected) != nil {
+		return nil, false
+	}
+	return expected, true
+}
+
+// constMessage builds the message of a const violation, naming the expected value
+func constMessage(err gojsonschema.ResultError) (string, bool) {
+	if err.Type() != "const" {
+		return "", false
+	}
+
+	allowed, ok := err.Details()["allowed"].(string)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("o valor deve ser igual a %s", allowed), true
+}
@@ -0,0 +1,62 @@
+package valid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestConstErrors(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {
+			"status": {"const": "active"},
+			"version": {"const": 2, "errorMessage": {"const": "{field} deve ser {limit}, recebido {value}"}}
+		}
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"status": "inactive", "version": 3}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Fatal("esperava dados inválidos")
+	}
+
+	errorsByField := make(map[string]ValidationError)
+	for _, validationErr := range result.Errors {
+		errorsByField[validationErr.Field] = validationErr
+	}
+
+	status := errorsByField["status"]
+	if status.Constraint != "const" || status.Code != "validation.const" {
+		t.Errorf("esperava violação de const, recebeu %+v", status)
+	}
+	if status.Message != `o valor deve ser igual a "active"` {
+		t.Errorf("mensagem inesperada '%s'", status.Message)
+	}
+	if status.Value != "inactive" {
+		t.Errorf("esperava valor 'inactive', recebeu %v", status.Value)
+	}
+	if status.Details["expected"] != "active" {
+		t.Errorf("esperava valor esperado 'active' nos detalhes, recebeu %v", status.Details["expected"])
+	}
+
+	version := errorsByField["version"]
+	if version.Message != "version deve ser 2, recebido 3" {
+		t.Errorf("esperava mensagem personalizada, recebeu '%s'", version.Message)
+	}
+	if version.Details["expected"] != json.Number("2") {
+		t.Errorf("esperava valor esperado 2 nos detalhes, recebeu %v (%T)", version.Details["expected"], version.Details["expected"])
+	}
+
+	result, err = validator.ValidateString(`{"status": "active", "version": 2}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava dados válidos, recebeu %+v", result.Errors)
+	}
+}
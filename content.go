@@ -0,0 +1,74 @@
+package valid
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// assertContent validates string values against the contentEncoding and
+// contentMediaType keywords of their schemas
+func (v *Validator) assertContent(document interface{}) []ValidationError {
+	var errs []ValidationError
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		str, ok := value.(string)
+		if !ok {
+			return
+		}
+
+		for _, schema := range schemas {
+			content := []byte(str)
+
+			if encoding, ok := schema["contentEncoding"].(string); ok {
+				decoded, err := decodeContent(encoding, str)
+				if err != nil {
+					errs = append(errs, ValidationError{
+						Field:      path,
+						Message:    fmt.Sprintf("conteúdo não está codificado em %s: %s", encoding, err.Error()),
+						Constraint: "contentEncoding",
+						Code:       v.errorCode(path, "contentEncoding"),
+					})
+					continue
+				}
+				content = decoded
+			}
+
+			if mediaType, ok := schema["contentMediaType"].(string); ok && isJSONMediaType(mediaType) && !json.Valid(content) {
+				errs = append(errs, ValidationError{
+					Field:      path,
+					Message:    fmt.Sprintf("conteúdo não é um %s válido", mediaType),
+					Constraint: "contentMediaType",
+					Code:       v.errorCode(path, "contentMediaType"),
+				})
+			}
+		}
+	})
+
+	return errs
+}
+
+// decodeContent decodes a string with the given contentEncoding. Encodings
+// other than base64 are not asserted and the string is returned as is.
+func decodeContent(encoding, value string) ([]byte, error) {
+	if !strings.EqualFold(encoding, "base64") {
+		return []byte(value), nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		// Accepts unpadded input, which is common in practice
+		if raw, rawErr := base64.RawStdEncoding.DecodeString(value); rawErr == nil {
+			return raw, nil
+		}
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// isJSONMediaType reports whether mediaType describes a JSON document
+func isJSONMediaType(mediaType string) bool {
+	mediaType = strings.ToLower(strings.TrimSpace(strings.Split(mediaType, ";")[0]))
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,113 @@
+package valid
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestAssertContent(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"payload": {
+				"type": "string",
+				"contentEncoding": "base64",
+				"contentMediaType": "application/json"
+			},
+			"blob": {"type": "string", "contentEncoding": "base64"}
+		}
+	}`)
+
+	validPayload := base64.StdEncoding.EncodeToString([]byte(`{"ok": true}`))
+	invalidPayload := base64.StdEncoding.EncodeToString([]byte(`not json`))
+
+	// Without the option the keywords are annotations only
+	validator, err := NewFromBytes(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err := validator.ValidateString(`{"payload": "%%%"}`)
+	if err != nil || !result.Valid {
+		t.Errorf("esperava documento válido sem AssertContent, recebeu %+v, %v", result, err)
+	}
+
+	validator, err = NewFromBytesWithOptions(schema, Options{AssertContent: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		jsonData         string
+		expectValid      bool
+		expectConstraint string
+	}{
+		{name: "valid encoded json", jsonData: `{"payload": "` + validPayload + `"}`, expectValid: true},
+		{name: "invalid base64", jsonData: `{"blob": "%%%"}`, expectValid: false, expectConstraint: "contentEncoding"},
+		{name: "decoded content is not json", jsonData: `{"payload": "` + invalidPayload + `"}`, expectValid: false, expectConstraint: "contentMediaType"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Fatalf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+			if tt.expectConstraint == "" {
+				return
+			}
+			if result.Errors[0].Constraint != tt.expectConstraint {
+				t.Errorf("esperava constraint '%s', recebeu '%s'", tt.expectConstraint, result.Errors[0].Constraint)
+			}
+			if result.Errors[0].Field != "payload" && result.Errors[0].Field != "blob" {
+				t.Errorf("campo inesperado '%s'", result.Errors[0].Field)
+			}
+		})
+	}
+}
+
+func TestAssertContentMatchedBranchOnly(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"payload": {
+				"anyOf": [
+					{"type": "string", "maxLength": 3},
+					{"type": "string", "minLength": 4, "contentEncoding": "base64"}
+				]
+			}
+		}
+	}`
+
+	validator, err := NewFromBytesWithOptions([]byte(schema), Options{AssertContent: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		jsonData    string
+		expectValid bool
+	}{
+		// "a!b" only satisfies the first branch, whose keywords are the ones checked
+		{name: "first branch", jsonData: `{"payload": "a!b"}`, expectValid: true},
+		{name: "base64 branch", jsonData: `{"payload": "aGVsbG8="}`, expectValid: true},
+		// matches the second branch by length, so its encoding is asserted
+		{name: "invalid base64", jsonData: `{"payload": "não é base64"}`, expectValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Errorf("esperava valid=%v, recebeu %+v", tt.expectValid, result.Errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package valid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Option adjusts a single validation call made through the *Context methods.
+//
+// Call-level options take precedence over the constructor-level Options for
+// that call only: FailFast, MaxErrors and CoerceTypes given to a call replace
+// the FailFast, MaxErrors and CoerceTypes set when the validator was built.
+// The remaining Options keep applying to every call.
+type Option func(*callOptions)
+
+// callOptions holds the settings of a validation call
+type callOptions struct {
+	maxErrors   int
+	coerceTypes bool
+}
+
+// FailFast reports only the first error-level violation
+func FailFast() Option {
+	return MaxErrors(1)
+}
+
+// MaxErrors reports at most n violations; zero or less reports all of them
+func MaxErrors(n int) Option {
+	return func(o *callOptions) {
+		o.maxErrors = n
+	}
+}
+
+// CoerceTypes converts string values to the number, integer or boolean type
+// their schema expects before validating, as sent by query strings and forms
+func CoerceTypes(enabled bool) Option {
+	return func(o *callOptions) {
+		o.coerceTypes = enabled
+	}
+}
+
+// callOptions returns the settings of a call, starting from the constructor
+// Options and applying the call-level options over them
+func (v *Validator) callOptions(opts []Option) callOptions {
+	settings := callOptions{
+		maxErrors:   v.opts.MaxErrors,
+		coerceTypes: v.opts.CoerceTypes,
+	}
+	if v.opts.FailFast {
+		settings.maxErrors = 1
+	}
+
+	for _, opt := range opts {
+		opt(&settings)
+	}
+	return settings
+}
+
+// ValidateBytesContext validates JSON bytes against the schema with per-call
+// options. The context is checked before and after validating, gojsonschema
+// itself can not be interrupted.
+func (v *Validator) ValidateBytesContext(ctx context.Context, jsonData []byte, opts ...Option) (*ValidationResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	v = v.active()
+	settings := v.callOptions(opts)
+
+	var start time.Time
+	if v.opts.MeasureTiming {
+		start = time.Now()
+	}
+
+	document := v.documentBytes(jsonData)
+	if len(document) == 0 {
+		return nil, fmt.Errorf("dados JSON não podem estar vazios")
+	}
+
+	// Results of identical bytes validated with the same settings are reused
+	var result *ValidationResult
+	var key resultCacheKey
+	cached := false
+	if v.results != nil {
+		key = v.cacheKey(document, settings)
+		result, cached = v.results.get(key)
+	}
+
+	if !cached {
+		var err error
+		result, err = v.validateJSON(document, settings)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		v.finalizeResult(result)
+		limitErrors(result, settings.maxErrors)
+
+		if v.results != nil {
+			v.results.put(key, result)
+		}
+	}
+
+	if v.opts.CaptureRaw {
+		result.Raw = document
+	}
+
+	if v.opts.MeasureTiming {
+		result.Duration = time.Since(start)
+	}
+
+	return result, nil
+}
+
+// validateJSON runs the checks of a JSON document in order: the size and depth limits,
+// normalization and type coercion, the schema and the post-validation checks
+func (v *Validator) validateJSON(jsonData []byte, settings callOptions) (*ValidationResult, error) {
+	if result := v.checkDocumentSize(jsonData); result != nil {
+		return result, nil
+	}
+	if result := v.checkDepth(jsonData); result != nil {
+		return result, nil
+	}
+
+	document := jsonData
+	if settings.coerceTypes || v.opts.Normalize {
+		document = v.transformDocument(jsonData, v.opts.Normalize, settings.coerceTypes)
+	}
+
+	result, err := v.validateDocument(document)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.opts.Normalize {
+		result.Normalized = document
+	}
+
+	if !isMalformed(result) {
+		if err := v.postValidate(document, result); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateStringContext validates a JSON string against the schema with per-call options
+func (v *Validator) ValidateStringContext(ctx context.Context, jsonString string, opts ...Option) (*ValidationResult, error) {
+	return v.ValidateBytesContext(ctx, []byte(jsonString), opts...)
+}
+
+// ValidateInterfaceContext validates an interface{} against the schema with per-call options
+func (v *Validator) ValidateInterfaceContext(ctx context.Context, data interface{}, opts ...Option) (*ValidationResult, error) {
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao serializar dados para JSON: %w", err)
+	}
+
+	return v.ValidateBytesContext(ctx, jsonBytes, opts...)
+}
+
+// limitErrors keeps at most max violations, error-level ones first, so the
+// validity of the result is unchanged
+func limitErrors(result *ValidationResult, max int) {
+	if max <= 0 || len(result.Errors) <= max {
+		return
+	}
+
+	limited := make([]ValidationError, 0, max)
+	for _, severity := range []Severity{SeverityError, SeverityWarning} {
+		for _, validationErr := range result.Errors {
+			if len(limited) < max && validationErr.Severity == severity {
+				limited = append(limited, validationErr)
+			}
+		}
+	}
+	result.Errors = limited
+}
@@ -0,0 +1,37 @@
+package valid
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MiddlewareFromContext returns a middleware that validates each request with
+// the validator whose key an earlier middleware or router stored in the
+// request context under contextKey. Requests without a string key in the
+// context skip validation; a key with no registered validator responds 500.
+// The options are those of MiddlewareFunc.
+func (mv *MultiValidator) MiddlewareFromContext(contextKey interface{}, opts ...MiddlewareOption) func(next http.HandlerFunc) http.HandlerFunc {
+	var config MiddlewareConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			key, ok := r.Context().Value(contextKey).(string)
+			if !ok || key == "" {
+				next(w, r)
+				return
+			}
+
+			validator, exists := mv.Get(key)
+			if !exists {
+				http.Error(w, fmt.Sprintf("Erro interno de validação: validator '%s' não registrado", key),
+					http.StatusInternalServerError)
+				return
+			}
+
+			validator.MiddlewareWithConfig(config, next)(w, r)
+		}
+	}
+}
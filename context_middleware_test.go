@@ -0,0 +1,67 @@
+package valid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// schemaKey is the context key set by the router in the tests
+type schemaKey struct{}
+
+func TestMiddlewareFromContext(t *testing.T) {
+	mv := NewMultiValidator()
+	if err := mv.AddFromString("user", testSchema); err != nil {
+		t.Fatalf("erro ao adicionar validator: %v", err)
+	}
+	if err := mv.AddFromString("product", `{"type": "object", "required": ["sku"]}`); err != nil {
+		t.Fatalf("erro ao adicionar validator: %v", err)
+	}
+
+	var called bool
+	validate := mv.MiddlewareFromContext(schemaKey{}, WithErrorStatusCode(http.StatusUnprocessableEntity))(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	// Earlier middleware annotating the request with the schema key
+	router := func(key interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if key != nil {
+				r = r.WithContext(context.WithValue(r.Context(), schemaKey{}, key))
+			}
+			validate(w, r)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		key    interface{}
+		body   string
+		status int
+		called bool
+	}{
+		{"usuário válido", "user", `{"name": "Ana", "email": "ana@x.com"}`, http.StatusOK, true},
+		{"usuário inválido", "user", `{"sku": "X1"}`, http.StatusUnprocessableEntity, false},
+		{"produto válido", "product", `{"sku": "X1"}`, http.StatusOK, true},
+		{"sem chave", nil, `{"qualquer": true}`, http.StatusOK, true},
+		{"chave não string", 42, `{"qualquer": true}`, http.StatusOK, true},
+		{"chave não registrada", "order", `{}`, http.StatusInternalServerError, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			w := httptest.NewRecorder()
+			router(tt.key)(w, httptest.NewRequest("POST", "/items", strings.NewReader(tt.body)))
+
+			if w.Code != tt.status {
+				t.Errorf("esperava status %d, recebeu %d: %s", tt.status, w.Code, w.Body.String())
+			}
+			if called != tt.called {
+				t.Errorf("esperava handler chamado=%v, recebeu %v", tt.called, called)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package valid
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+const coerceSchema = `{
+	"type": "object",
+	"properties": {
+		"page": {"type": "integer", "minimum": 1},
+		"price": {"type": "number"},
+		"active": {"type": "boolean"},
+		"code": {"type": "string"},
+		"ids": {"type": "array", "items": {"type": "integer"}}
+	}
+}`
+
+func TestValidateContextCancelled(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := validator.ValidateStringContext(ctx, `{"name": "Ana"}`); !errors.Is(err, context.Canceled) {
+		t.Errorf("esperava context.Canceled, recebeu %v", err)
+	}
+	if _, err := validator.ValidateInterfaceContext(ctx, map[string]string{"name": "Ana"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("esperava context.Canceled, recebeu %v", err)
+	}
+}
+
+func TestMaxErrorsAndFailFast(t *testing.T) {
+	invalid := `{"name": "A", "email": "invalido", "age": 200}`
+
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	ctx := context.Background()
+
+	result, _ := validator.ValidateStringContext(ctx, invalid)
+	if len(result.Errors) != 3 {
+		t.Fatalf("esperava 3 erros sem limite, recebeu %+v", result.Errors)
+	}
+
+	result, _ = validator.ValidateStringContext(ctx, invalid, MaxErrors(2))
+	if len(result.Errors) != 2 || result.Valid {
+		t.Errorf("esperava 2 erros com MaxErrors(2), recebeu %+v", result.Errors)
+	}
+
+	result, _ = validator.ValidateStringContext(ctx, invalid, FailFast())
+	if len(result.Errors) != 1 {
+		t.Errorf("esperava 1 erro com FailFast, recebeu %+v", result.Errors)
+	}
+
+	// Constructor-level settings apply to every call, call-level ones take precedence
+	failFast, err := NewFromBytesWithOptions([]byte(testSchema), Options{FailFast: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, _ = failFast.ValidateString(invalid)
+	if len(result.Errors) != 1 {
+		t.Errorf("esperava 1 erro com FailFast no construtor, recebeu %+v", result.Errors)
+	}
+	result, _ = failFast.ValidateStringContext(ctx, invalid, MaxErrors(0))
+	if len(result.Errors) != 3 {
+		t.Errorf("a opção da chamada deveria prevalecer sobre a do construtor, recebeu %+v", result.Errors)
+	}
+}
+
+func TestCoerceTypes(t *testing.T) {
+	validator, err := NewFromString(coerceSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	ctx := context.Background()
+	document := `{"page": "2", "price": "9.90", "active": "true", "code": "007", "ids": ["1", "2"]}`
+
+	result, _ := validator.ValidateStringContext(ctx, document)
+	if result.Valid {
+		t.Error("esperava erros de tipo sem CoerceTypes")
+	}
+
+	result, _ = validator.ValidateStringContext(ctx, document, CoerceTypes(true))
+	if !result.Valid {
+		t.Errorf("esperava documento válido com CoerceTypes, recebeu %+v", result.Errors)
+	}
+
+	// Coerced values are still checked against the other constraints
+	result, _ = validator.ValidateStringContext(ctx, `{"page": "0", "active": "sim"}`, CoerceTypes(true))
+	if !result.HasFieldError("page") || !result.HasFieldError("active") {
+		t.Errorf("esperava erros em 'page' e 'active', recebeu %+v", result.Errors)
+	}
+
+	// Strings that are not JSON numbers stay strings
+	result, _ = validator.ValidateStringContext(ctx, `{"page": "+3"}`, CoerceTypes(true))
+	if result.Valid {
+		t.Error("esperava erro de tipo para '+3'")
+	}
+}
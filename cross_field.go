@@ -0,0 +1,216 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// CrossFieldRule checks relations between fields of a decoded document, such
+// as an end date after a start date, returning the violations found
+type CrossFieldRule func(doc map[string]interface{}) []ValidationError
+
+// crossFieldRules holds the rules added to a validator. It is shared with the
+// validators built by Reload, so rules survive schema changes.
+type crossFieldRules struct {
+	mu    sync.RWMutex
+	names []string
+	rules map[string]CrossFieldRule
+	// version counts the changes to the rules, so cached results are not reused across them
+	version uint64
+}
+
+// AddCrossFieldRule adds a rule run after schema validation on documents whose
+// root is an object. The rule name is the Constraint of the errors it returns
+// without one; adding a rule with an existing name replaces it.
+func (v *Validator) AddCrossFieldRule(name string, fn CrossFieldRule) {
+	rules := v.crossRules
+
+	rules.mu.Lock()
+	defer rules.mu.Unlock()
+
+	if rules.rules == nil {
+		rules.rules = make(map[string]CrossFieldRule)
+	}
+	if _, exists := rules.rules[name]; !exists {
+		rules.names = append(rules.names, name)
+	}
+	rules.rules[name] = fn
+	rules.version++
+}
+
+// len returns the number of rules added
+func (r *crossFieldRules) len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.names)
+}
+
+// comparisonKeywords are the schema extensions comparing a property with a
+// sibling property of the same object, mapped to the message of their violations
+var comparisonKeywords = map[string]string{
+	"x-greater-than": "o campo '%s' deve ser maior que '%s'",
+	"x-equal-to":     "o campo '%s' deve ser igual a '%s'",
+}
+
+// usesComparisons reports whether the schema uses a comparison keyword
+func (v *Validator) usesComparisons() bool {
+	for keyword := range comparisonKeywords {
+		if v.schemaKeywords[keyword] {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCrossFields runs the comparison keywords of the schema and the rules
+// added with AddCrossFieldRule
+func (v *Validator) checkCrossFields(document interface{}) []ValidationError {
+	var errs []ValidationError
+
+	if v.usesComparisons() {
+		errs = append(errs, v.checkComparisons(document)...)
+	}
+
+	doc, ok := document.(map[string]interface{})
+	if !ok {
+		return errs
+	}
+
+	rules := v.crossRules
+	rules.mu.RLock()
+	defer rules.mu.RUnlock()
+
+	for _, name := range rules.names {
+		for _, ruleErr := range rules.rules[name](doc) {
+			if ruleErr.Constraint == "" {
+				ruleErr.Constraint = name
+			}
+			errs = append(errs, v.completeError(ruleErr, ruleErr.Field))
+		}
+	}
+
+	return errs
+}
+
+// checkComparisons checks every present property carrying x-greater-than or
+// x-equal-to against the sibling property it names. Properties missing on
+// either side are left to required.
+func (v *Validator) checkComparisons(document interface{}) []ValidationError {
+	var errs []ValidationError
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for _, schema := range schemas {
+			properties, _ := schema["properties"].(map[string]interface{})
+			for name, property := range properties {
+				propertySchema, _ := property.(map[string]interface{})
+				current, present := object[name]
+				if !present {
+					continue
+				}
+
+				for keyword, message := range comparisonKeywords {
+					other, ok := propertySchema[keyword].(string)
+					if !ok {
+						continue
+					}
+					otherValue, present := object[other]
+					if !present || compareFields(keyword, current, otherValue) {
+						continue
+					}
+
+					field, otherField := joinFieldPath(path, name), joinFieldPath(path, other)
+					errs = append(errs, v.completeError(ValidationError{
+						Field:      field,
+						Fields:     []string{field, otherField},
+						Message:    fmt.Sprintf(message, field, otherField),
+						Value:      current,
+						Constraint: keyword,
+					}, field))
+				}
+			}
+		}
+	})
+
+	sortErrorsByField(errs)
+
+	return errs
+}
+
+// compareFields reports whether value satisfies a comparison keyword against
+// other. Values that can not be ordered, like a number and a string, satisfy
+// x-greater-than, leaving the type mismatch to the schema.
+func compareFields(keyword string, value, other interface{}) bool {
+	if keyword == "x-equal-to" {
+		if a, ok := fieldNumber(value); ok {
+			if b, ok := fieldNumber(other); ok {
+				return a.Cmp(b) == 0
+			}
+		}
+		return reflect.DeepEqual(value, other)
+	}
+
+	if a, ok := fieldNumber(value); ok {
+		if b, ok := fieldNumber(other); ok {
+			return a.Cmp(b) > 0
+		}
+		return true
+	}
+
+	a, ok := value.(string)
+	if !ok {
+		return true
+	}
+	b, ok := other.(string)
+	if !ok {
+		return true
+	}
+
+	if timeA, ok := parseFieldTime(a); ok {
+		if timeB, ok := parseFieldTime(b); ok {
+			return timeA.After(timeB)
+		}
+	}
+	return a > b
+}
+
+// fieldNumber returns the exact value of a number decoded as float64 or
+// json.Number, so numbers decoded with UseNumber compare without rounding
+func fieldNumber(value interface{}) (*big.Rat, bool) {
+	switch number := value.(type) {
+	case float64:
+		if math.IsInf(number, 0) || math.IsNaN(number) {
+			return nil, false
+		}
+		return new(big.Rat).SetFloat64(number), true
+	case json.Number:
+		return new(big.Rat).SetString(string(number))
+	}
+	return nil, false
+}
+
+// parseFieldTime parses the date-time and date formats of JSON Schema
+func parseFieldTime(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// generation returns the number of changes made to the rules
+func (r *crossFieldRules) generation() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.version
+}
@@ -0,0 +1,142 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+const crossFieldSchema = `{
+	"type": "object",
+	"properties": {
+		"startDate": {"type": "string", "format": "date"},
+		"endDate": {"type": "string", "format": "date", "x-greater-than": "startDate"},
+		"password": {"type": "string"},
+		"confirmPassword": {"type": "string", "x-equal-to": "password", "errorMessage": {"x-equal-to": "as senhas não conferem"}},
+		"range": {
+			"type": "object",
+			"properties": {
+				"min": {"type": "number"},
+				"max": {"type": "number", "x-greater-than": "min"}
+			}
+		}
+	}
+}`
+
+func TestCrossFieldComparisons(t *testing.T) {
+	validator, err := NewFromString(crossFieldSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		jsonData      string
+		expectField   string
+		expectFields  []string
+		expectMessage string
+	}{
+		{name: "dates in order", jsonData: `{"startDate": "2024-01-01", "endDate": "2024-02-01"}`},
+		{name: "other field missing", jsonData: `{"endDate": "2024-02-01"}`},
+		{name: "matching passwords", jsonData: `{"password": "s3cr3t", "confirmPassword": "s3cr3t"}`},
+		{name: "nested numbers in order", jsonData: `{"range": {"min": 1, "max": 10}}`},
+		{
+			name:          "end before start",
+			jsonData:      `{"startDate": "2024-02-01", "endDate": "2024-01-01"}`,
+			expectField:   "endDate",
+			expectFields:  []string{"endDate", "startDate"},
+			expectMessage: "o campo 'endDate' deve ser maior que 'startDate'",
+		},
+		{
+			name:          "different passwords",
+			jsonData:      `{"password": "s3cr3t", "confirmPassword": "other"}`,
+			expectField:   "confirmPassword",
+			expectFields:  []string{"confirmPassword", "password"},
+			expectMessage: "as senhas não conferem",
+		},
+		{
+			name:          "nested numbers out of order",
+			jsonData:      `{"range": {"min": 10, "max": 10}}`,
+			expectField:   "range.max",
+			expectFields:  []string{"range.max", "range.min"},
+			expectMessage: "o campo 'range.max' deve ser maior que 'range.min'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if tt.expectField == "" {
+				if !result.Valid {
+					t.Errorf("esperava resultado válido, recebeu %+v", result.Errors)
+				}
+				return
+			}
+
+			if result.Valid || len(result.Errors) != 1 {
+				t.Fatalf("esperava 1 erro, recebeu %+v", result.Errors)
+			}
+			validationErr := result.Errors[0]
+			if validationErr.Field != tt.expectField {
+				t.Errorf("esperava campo '%s', recebeu '%s'", tt.expectField, validationErr.Field)
+			}
+			if !reflect.DeepEqual(validationErr.Fields, tt.expectFields) {
+				t.Errorf("esperava campos %v, recebeu %v", tt.expectFields, validationErr.Fields)
+			}
+			if validationErr.Message != tt.expectMessage {
+				t.Errorf("esperava mensagem '%s', recebeu '%s'", tt.expectMessage, validationErr.Message)
+			}
+		})
+	}
+}
+
+func TestAddCrossFieldRule(t *testing.T) {
+	validator, err := NewFromString(`{"type": "object", "properties": {"country": {"type": "string"}, "state": {"type": "string"}}}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	validator.AddCrossFieldRule("stateRequiredForBR", func(doc map[string]interface{}) []ValidationError {
+		if doc["country"] == "BR" && doc["state"] == nil {
+			return []ValidationError{{
+				Field:   "state",
+				Fields:  []string{"state", "country"},
+				Message: "o campo 'state' é obrigatório quando 'country' é BR",
+			}}
+		}
+		return nil
+	})
+
+	result, err := validator.ValidateString(`{"country": "BR"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 {
+		t.Fatalf("esperava 1 erro, recebeu %+v", result.Errors)
+	}
+	if validationErr := result.Errors[0]; validationErr.Constraint != "stateRequiredForBR" || validationErr.Code != "validation.stateRequiredForBR" {
+		t.Errorf("esperava constraint e código da regra, recebeu %+v", validationErr)
+	}
+
+	// Rules survive a reload of the schema
+	if err := validator.Reload([]byte(`{"type": "object"}`)); err != nil {
+		t.Fatalf("erro ao recarregar schema: %v", err)
+	}
+	result, err = validator.ValidateString(`{"country": "BR"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Error("esperava que a regra continuasse ativa após o reload")
+	}
+
+	result, err = validator.ValidateString(`{"country": "US"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava resultado válido, recebeu %+v", result.Errors)
+	}
+}
@@ -0,0 +1,54 @@
+package valid
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// csvColumns are the columns written by WriteCSV
+var csvColumns = []string{"rowID", "field", "constraint", "message", "value"}
+
+// WriteCSVHeader writes the header row of the columns produced by WriteCSV,
+// written once before the rows of every result of a batch
+func WriteCSVHeader(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvColumns); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteCSV writes one CSV row per violation with the columns rowID, field,
+// constraint, message and value, rowID identifying the validated record (e.g.
+// the InvalidDoc index). String values are written as is, other values as
+// JSON and absent values as an empty cell. Valid results write no rows.
+func (vr *ValidationResult) WriteCSV(w io.Writer, rowID string) error {
+	writer := csv.NewWriter(w)
+	for _, validationErr := range vr.Errors {
+		row := []string{rowID, validationErr.Field, validationErr.Constraint, validationErr.Message, csvValue(validationErr.Value)}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvValue renders an offending value for a CSV cell
+func csvValue(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
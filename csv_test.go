@@ -0,0 +1,52 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	result := &ValidationResult{
+		Valid: false,
+		Errors: []ValidationError{
+			{Field: "name", Constraint: "string_gte", Message: `o nome "curto", inválido`, Value: "A"},
+			{Field: "tags", Constraint: "array_max_items", Message: "muitos itens", Value: []interface{}{"a", "b, c"}},
+			{Field: "", Constraint: "required", Message: "email é obrigatório"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSVHeader(&buf); err != nil {
+		t.Fatalf("erro ao escrever cabeçalho: %v", err)
+	}
+	if err := result.WriteCSV(&buf, "7"); err != nil {
+		t.Fatalf("erro ao escrever CSV: %v", err)
+	}
+	if err := (&ValidationResult{Valid: true}).WriteCSV(&buf, "8"); err != nil {
+		t.Fatalf("erro ao escrever CSV: %v", err)
+	}
+
+	expectedText := "rowID,field,constraint,message,value\n" +
+		"7,name,string_gte,\"o nome \"\"curto\"\", inválido\",A\n" +
+		"7,tags,array_max_items,muitos itens,\"[\"\"a\"\",\"\"b, c\"\"]\"\n" +
+		"7,,required,email é obrigatório,\n"
+	if buf.String() != expectedText {
+		t.Errorf("CSV inesperado:\n%s", buf.String())
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV gerado não pôde ser lido: %v", err)
+	}
+	expected := [][]string{
+		{"rowID", "field", "constraint", "message", "value"},
+		{"7", "name", "string_gte", `o nome "curto", inválido`, "A"},
+		{"7", "tags", "array_max_items", "muitos itens", `["a","b, c"]`},
+		{"7", "", "required", "email é obrigatório", ""},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("esperava %q, recebeu %q", expected, records)
+	}
+}
@@ -0,0 +1,73 @@
+package valid
+
+import (
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// Layouts of the opt-in date formats
+const (
+	dateTimeSQLLayout = "2006-01-02 15:04:05"
+	dateBRLayout      = "02/01/2006"
+)
+
+// dateFormats are the opt-in date formats registered by RegisterDateFormats
+var dateFormats = map[string]FormatFunc{
+	"datetime-sql": DateTimeSQLFormat,
+	"unix-seconds": UnixSecondsFormat,
+	"date-br":      DateBRFormat,
+}
+
+// RegisterDateFormats registers the date formats partners send beyond RFC 3339:
+//
+//   - datetime-sql: "2006-01-02 15:04:05"
+//   - unix-seconds: whole seconds since the Unix epoch, as a number or a string of digits
+//   - date-br: "dd/mm/yyyy"
+//
+// They are not registered by default. Formats already registered, by an
+// earlier call or under the same name, are kept and reported in the error.
+func RegisterDateFormats() error {
+	var firstErr error
+	for _, name := range []string{"datetime-sql", "unix-seconds", "date-br"} {
+		if err := RegisterFormat(name, dateFormats[name]); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// DateTimeSQLFormat checks strings in the "2006-01-02 15:04:05" layout of SQL datetimes
+func DateTimeSQLFormat(input interface{}) bool {
+	return checkDateLayout(input, dateTimeSQLLayout)
+}
+
+// DateBRFormat checks strings in the dd/mm/yyyy layout
+func DateBRFormat(input interface{}) bool {
+	return checkDateLayout(input, dateBRLayout)
+}
+
+// UnixSecondsFormat checks Unix timestamps in whole seconds, given as a number
+// or as a string of digits with an optional minus sign
+func UnixSecondsFormat(input interface{}) bool {
+	switch typed := input.(type) {
+	case string:
+		_, err := strconv.ParseInt(typed, 10, 64)
+		return err == nil && typed[0] != '+'
+	case *big.Rat:
+		// gojsonschema hands numbers to format checkers as *big.Rat
+		return typed.IsInt() && typed.Num().IsInt64()
+	default:
+		return true
+	}
+}
+
+// checkDateLayout reports whether a string input is a valid date in layout
+func checkDateLayout(input interface{}, layout string) bool {
+	value, ok := input.(string)
+	if !ok {
+		return true
+	}
+	_, err := time.Parse(layout, value)
+	return err == nil
+}
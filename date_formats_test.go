@@ -0,0 +1,60 @@
+package valid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDateFormats(t *testing.T) {
+	if err := RegisterDateFormats(); err != nil {
+		t.Fatalf("erro ao registrar formatos de data: %v", err)
+	}
+	defer func() {
+		for name := range dateFormats {
+			UnregisterFormat(name)
+		}
+	}()
+
+	if err := RegisterDateFormats(); err == nil {
+		t.Error("esperava erro ao registrar os formatos novamente")
+	}
+
+	tests := []struct {
+		format string
+		value  string
+		valid  bool
+	}{
+		{"datetime-sql", `"2024-02-29 23:59:59"`, true},
+		{"datetime-sql", `"2024-02-30 10:00:00"`, false},
+		{"datetime-sql", `"2024-02-01T10:00:00Z"`, false},
+		{"datetime-sql", `"2024-02-01 25:00:00"`, false},
+		{"unix-seconds", `1700000000`, true},
+		{"unix-seconds", `"1700000000"`, true},
+		{"unix-seconds", `"-86400"`, true},
+		{"unix-seconds", `1700000000.5`, false},
+		{"unix-seconds", `"1700000000.5"`, false},
+		{"unix-seconds", `"+1700000000"`, false},
+		{"unix-seconds", `""`, false},
+		{"date-br", `"31/12/2024"`, true},
+		{"date-br", `"29/02/2023"`, false},
+		{"date-br", `"12/31/2024"`, false},
+		{"date-br", `"1/2/2024"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.format, tt.value), func(t *testing.T) {
+			validator, err := NewFromBytesWithOptions([]byte(fmt.Sprintf(`{"properties": {"when": {"format": %q}}}`, tt.format)), Options{StrictFormats: true})
+			if err != nil {
+				t.Fatalf("erro ao criar validator: %v", err)
+			}
+
+			result, err := validator.ValidateString(fmt.Sprintf(`{"when": %s}`, tt.value))
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.valid {
+				t.Errorf("esperava Valid=%v, recebeu %v: %+v", tt.valid, result.Valid, result.Errors)
+			}
+		})
+	}
+}
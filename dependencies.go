@@ -0,0 +1,109 @@
+package valid
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// dependencyRule is a property dependency declared with the Draft 7
+// "dependencies" keyword: when trigger is present, required must be too
+type dependencyRule struct {
+	trigger  string
+	required []string
+	// message is the custom message set in errorMessage.dependencies, if any
+	message string
+}
+
+// extractDependencies collects the property dependencies of every subschema,
+// along with the custom messages set for them. A message may be set in the
+// errorMessage.dependencies of the object, as a string or as an object keyed by
+// the triggering property, or in the errorMessage.dependencies of the
+// triggering property itself.
+func extractDependencies(schema map[string]interface{}) []dependencyRule {
+	var rules []dependencyRule
+
+	walkSubschemas(schema, func(subschema map[string]interface{}) {
+		dependencies, ok := subschema["dependencies"].(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for trigger, dependency := range dependencies {
+			list, ok := dependency.([]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := dependencyRule{trigger: trigger}
+			for _, item := range list {
+				if name, ok := item.(string); ok {
+					rule.required = append(rule.required, name)
+				}
+			}
+
+			switch message := dependencyMessageSetting(subschema).(type) {
+			case string:
+				rule.message = message
+			case map[string]interface{}:
+				rule.message, _ = message[trigger].(string)
+			}
+
+			if props, ok := subschema["properties"].(map[string]interface{}); ok {
+				if prop, ok := props[trigger].(map[string]interface{}); ok {
+					if message, ok := dependencyMessageSetting(prop).(string); ok {
+						rule.message = message
+					}
+				}
+			}
+
+			rules = append(rules, rule)
+		}
+	})
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].trigger < rules[j].trigger
+	})
+
+	return rules
+}
+
+// dependencyMessageSetting returns the errorMessage.dependencies value of a schema
+func dependencyMessageSetting(schema map[string]interface{}) interface{} {
+	errMsg, ok := schema["errorMessage"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return errMsg["dependencies"]
+}
+
+// dependencyMessage builds the message of a missing dependency, naming both the
+// property that triggered it and the one that is missing
+func (v *Validator) dependencyMessage(err gojsonschema.ResultError) string {
+	required, _ := err.Details()["dependency"].(string)
+	object, _ := err.Value().(map[string]interface{})
+
+	for _, rule := range v.dependencies {
+		if _, present := object[rule.trigger]; !present || !rule.requires(required) {
+			continue
+		}
+
+		if rule.message != "" {
+			return rule.message
+		}
+		return fmt.Sprintf("o campo '%s' é obrigatório quando '%s' está presente", required, rule.trigger)
+	}
+
+	return err.Description()
+}
+
+// requires reports whether the rule requires the given property
+func (r dependencyRule) requires(name string) bool {
+	for _, required := range r.required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
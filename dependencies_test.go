@@ -0,0 +1,73 @@
+package valid
+
+import "testing"
+
+const dependencySchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string"},
+		"creditCard": {"type": "string"},
+		"billingAddress": {"type": "string"},
+		"coupon": {
+			"type": "string",
+			"errorMessage": {"dependencies": "cupom exige um cliente identificado"}
+		},
+		"customerId": {"type": "string"}
+	},
+	"dependencies": {
+		"creditCard": ["billingAddress"],
+		"coupon": ["customerId"]
+	}
+}`
+
+func TestDependencyMessages(t *testing.T) {
+	validator, err := NewFromString(dependencySchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "Ana", "creditCard": "4111"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 {
+		t.Fatalf("esperava um erro de dependência, recebeu %+v", result.Errors)
+	}
+
+	expected := "o campo 'billingAddress' é obrigatório quando 'creditCard' está presente"
+	if result.Errors[0].Message != expected {
+		t.Errorf("esperava mensagem '%s', recebeu '%s'", expected, result.Errors[0].Message)
+	}
+	if result.Errors[0].Code != "validation.dependencies" {
+		t.Errorf("esperava código 'validation.dependencies', recebeu '%s'", result.Errors[0].Code)
+	}
+
+	// Custom message set on the triggering property
+	result, _ = validator.ValidateString(`{"coupon": "PROMO"}`)
+	if len(result.Errors) != 1 || result.Errors[0].Message != "cupom exige um cliente identificado" {
+		t.Errorf("esperava mensagem personalizada, recebeu %+v", result.Errors)
+	}
+
+	result, _ = validator.ValidateString(`{"creditCard": "4111", "billingAddress": "Rua A"}`)
+	if !result.Valid {
+		t.Errorf("esperava dados válidos, recebeu %+v", result.Errors)
+	}
+}
+
+func TestDependencyObjectMessage(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"dependencies": {"creditCard": ["billingAddress"]},
+		"errorMessage": {"dependencies": {"creditCard": "informe o endereço de cobrança"}}
+	}`
+
+	validator, err := NewFromString(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, _ := validator.ValidateString(`{"creditCard": "4111"}`)
+	if len(result.Errors) != 1 || result.Errors[0].Message != "informe o endereço de cobrança" {
+		t.Errorf("esperava mensagem personalizada do objeto, recebeu %+v", result.Errors)
+	}
+}
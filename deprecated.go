@@ -0,0 +1,79 @@
+package valid
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// deprecatedKeyword is the schema extension that marks a property as deprecated.
+// It may be true or a string explaining what to use instead.
+const deprecatedKeyword = "x-deprecated"
+
+// deprecatedConstraint is the constraint of the warnings produced for deprecated fields
+const deprecatedConstraint = "deprecated"
+
+// Deprecations returns the warnings produced for deprecated fields present in the document
+func (vr *ValidationResult) Deprecations() []ValidationError {
+	var deprecations []ValidationError
+	for _, validationErr := range vr.Errors {
+		if validationErr.Constraint == deprecatedConstraint {
+			deprecations = append(deprecations, validationErr)
+		}
+	}
+	return deprecations
+}
+
+// findDeprecated returns a warning for every present value whose schema is
+// marked with x-deprecated
+func (v *Validator) findDeprecated(document interface{}) []ValidationError {
+	var warnings []ValidationError
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		if path == "" {
+			return
+		}
+
+		for _, schema := range schemas {
+			message := ""
+			switch deprecated := schema[deprecatedKeyword].(type) {
+			case bool:
+				if !deprecated {
+					continue
+				}
+				message = fmt.Sprintf("o campo '%s' está obsoleto", path)
+			case string:
+				message = fmt.Sprintf("o campo '%s' está obsoleto: %s", path, deprecated)
+			default:
+				continue
+			}
+
+			warnings = append(warnings, ValidationError{
+				Field:      path,
+				Message:    message,
+				Value:      value,
+				Constraint: deprecatedConstraint,
+				Code:       v.errorCode(path, deprecatedConstraint),
+				Severity:   SeverityWarning,
+			})
+			return
+		}
+	})
+
+	// The document is walked in map order
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].Field < warnings[j].Field
+	})
+
+	return warnings
+}
+
+// defaultDeprecationHandler sets the Deprecation header and one Warning header
+// per deprecated field present in the request
+func defaultDeprecationHandler(w http.ResponseWriter, r *http.Request, deprecations []ValidationError) {
+	w.Header().Set("Deprecation", "true")
+	for _, deprecation := range deprecations {
+		w.Header().Add("Warning", "299 - "+strconv.Quote(deprecation.Message))
+	}
+}
@@ -0,0 +1,90 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const deprecatedSchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string"},
+		"fax": {"type": "string", "x-deprecated": true},
+		"address": {
+			"type": "object",
+			"properties": {
+				"zip": {"type": "string", "x-deprecated": "use postalCode"},
+				"postalCode": {"type": "string"}
+			}
+		}
+	},
+	"required": ["name"]
+}`
+
+func TestWarnDeprecated(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(deprecatedSchema), Options{WarnDeprecated: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "Ana", "fax": "123", "address": {"zip": "01000"}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Fatalf("campos obsoletos não deveriam invalidar os dados, recebeu %+v", result.Errors)
+	}
+
+	deprecations := result.Deprecations()
+	if len(deprecations) != 2 {
+		t.Fatalf("esperava 2 campos obsoletos, recebeu %+v", deprecations)
+	}
+	if deprecations[0].Field != "address.zip" || deprecations[0].Message != "o campo 'address.zip' está obsoleto: use postalCode" {
+		t.Errorf("aviso inesperado para 'address.zip': %+v", deprecations[0])
+	}
+	if deprecations[1].Field != "fax" || deprecations[1].Severity != SeverityWarning || deprecations[1].Code != "validation.deprecated" {
+		t.Errorf("aviso inesperado para 'fax': %+v", deprecations[1])
+	}
+
+	// Invalid documents keep the deprecation warnings alongside the errors
+	result, _ = validator.ValidateString(`{"fax": "123"}`)
+	if result.Valid || len(result.Deprecations()) != 1 {
+		t.Errorf("esperava dados inválidos com um aviso, recebeu %+v", result.Errors)
+	}
+
+	// Without the option no warning is produced
+	plain, _ := NewFromString(deprecatedSchema)
+	result, _ = plain.ValidateString(`{"name": "Ana", "fax": "123"}`)
+	if len(result.Errors) != 0 {
+		t.Errorf("não esperava avisos sem a opção WarnDeprecated, recebeu %+v", result.Errors)
+	}
+}
+
+func TestMiddlewareDeprecationHeaders(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(deprecatedSchema), Options{WarnDeprecated: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		WarningHandler: func(r *http.Request, warnings []ValidationError) {},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "Ana", "fax": "123"}`))
+	rec := httptest.NewRecorder()
+	middleware(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("esperava status 200, recebeu %d", rec.Code)
+	}
+	if rec.Header().Get("Deprecation") != "true" {
+		t.Error("esperava header Deprecation")
+	}
+	if warning := rec.Header().Get("Warning"); warning != `299 - "o campo 'fax' está obsoleto"` {
+		t.Errorf("header Warning inesperado: %s", warning)
+	}
+}
@@ -0,0 +1,63 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// checkDepth returns a failed result when the document is nested deeper than
+// the MaxDepth option, or nil otherwise. Malformed documents are left to the
+// usual syntax check.
+func (v *Validator) checkDepth(jsonData []byte) *ValidationResult {
+	if v.opts.MaxDepth <= 0 || documentDepth(jsonData) <= v.opts.MaxDepth || !json.Valid(jsonData) {
+		return nil
+	}
+
+	return &ValidationResult{
+		Valid: false,
+		Errors: []ValidationError{
+			{
+				Field:      "",
+				Message:    fmt.Sprintf("documento excede a profundidade máxima de %d níveis", v.opts.MaxDepth),
+				Constraint: "maxDepth",
+				Code:       codePrefix + "maxDepth",
+				Severity:   SeverityError,
+			},
+		},
+	}
+}
+
+// documentDepth returns the deepest nesting of objects and arrays in the JSON
+// bytes, scanning them without decoding. Scalars have depth zero.
+func documentDepth(jsonData []byte) int {
+	depth, maxDepth := 0, 0
+	inString, escaped := false, false
+
+	for _, c := range jsonData {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+
+	return maxDepth
+}
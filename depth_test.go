@@ -0,0 +1,52 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaxDepth(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(`{"type": "object"}`), Options{MaxDepth: 3})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"a": {"b": [1, "{[{[", 2]}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava documento válido no limite de profundidade, recebeu %+v", result.Errors)
+	}
+
+	deep := strings.Repeat(`{"a": `, 50) + "1" + strings.Repeat("}", 50)
+	result, err = validator.ValidateString(deep)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Constraint != "maxDepth" {
+		t.Errorf("esperava erro maxDepth, recebeu %+v", result.Errors)
+	}
+
+	// Without the option the depth is unlimited
+	unlimited, _ := NewFromString(`{"type": "object"}`)
+	result, _ = unlimited.ValidateString(deep)
+	if !result.Valid {
+		t.Errorf("não esperava erro sem MaxDepth, recebeu %+v", result.Errors)
+	}
+}
+
+func TestDocumentDepth(t *testing.T) {
+	cases := map[string]int{
+		`1`:                     0,
+		`"[{"`:                  0,
+		`{}`:                    1,
+		`[[], {"a": [1]}]`:      3,
+		`{"a\"[": {"b": "\\"}}`: 2,
+	}
+	for document, expected := range cases {
+		if depth := documentDepth([]byte(document)); depth != expected {
+			t.Errorf("documentDepth(%s) = %d, esperava %d", document, depth, expected)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// derivedCache holds validators derived from the schema of a Validator, keyed by variant
+type derivedCache struct {
+	mu      sync.Mutex
+	entries map[string]derivedEntry
+}
+
+// derivedEntry is a derived validator along with the metadata its transform produced
+type derivedEntry struct {
+	validator *Validator
+	meta      interface{}
+}
+
+// derivedValidator returns the cached validator for key, building it on first use
+// from a copy of the schema rewritten by transform. The value returned by
+// transform is cached and returned alongside the validator.
+func (v *Validator) derivedValidator(key string, transform func(schema map[string]interface{}) interface{}) (*Validator, interface{}, error) {
+	v.derived.mu.Lock()
+	defer v.derived.mu.Unlock()
+
+	if cached, ok := v.derived.entries[key]; ok {
+		return cached.validator, cached.meta, nil
+	}
+
+	var schemaObj map[string]interface{}
+	if err := json.Unmarshal(v.schemaBytes, &schemaObj); err != nil {
+		return nil, nil, fmt.Errorf("schema JSON inválido: %w", err)
+	}
+
+	meta := transform(schemaObj)
+
+	derivedBytes, err := json.Marshal(schemaObj)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao derivar schema: %w", err)
+	}
+
+	// Relative $refs keep resolving against the schema file
+	derived, err := newValidator(derivedBytes, v.opts, v.schemaURI, v.store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Keeps the custom messages, codes, dependencies and cross-field rules of the original schema
+	derived.customErrors = v.customErrors
+	derived.customCodes = v.customCodes
+	derived.dependencies = v.dependencies
+	derived.crossRules = v.crossRules
+
+	if v.derived.entries == nil {
+		v.derived.entries = make(map[string]derivedEntry)
+	}
+	v.derived.entries[key] = derivedEntry{validator: derived, meta: meta}
+
+	return derived, meta, nil
+}
+
+// walkSubschemas calls fn for schema and, recursively, for every subschema it declares
+func walkSubschemas(schema map[string]interface{}, fn func(schema map[string]interface{})) {
+	walkSubschemasAt(schema, "", func(pointer string, subschema map[string]interface{}) {
+		fn(subschema)
+	})
+}
+
+// walkSubschemasAt is like walkSubschemas, also passing the JSON Pointer of each
+// subschema within schema
+func walkSubschemasAt(schema map[string]interface{}, pointer string, fn func(pointer string, schema map[string]interface{})) {
+	fn(pointer, schema)
+
+	for _, key := range []string{"properties", "definitions", "$defs", "patternProperties", "dependencies"} {
+		if children, ok := schema[key].(map[string]interface{}); ok {
+			for name, child := range children {
+				if childMap, ok := child.(map[string]interface{}); ok {
+					walkSubschemasAt(childMap, pointer+"/"+key+"/"+escapePointerToken(name), fn)
+				}
+			}
+		}
+	}
+
+	for _, key := range []string{"items", "additionalProperties", "additionalItems", "contains", "propertyNames", "not", "if", "then", "else"} {
+		if childMap, ok := schema[key].(map[string]interface{}); ok {
+			walkSubschemasAt(childMap, pointer+"/"+key, fn)
+		}
+	}
+
+	for _, key := range []string{"items", "allOf", "anyOf", "oneOf"} {
+		if children, ok := schema[key].([]interface{}); ok {
+			for i, child := range children {
+				if childMap, ok := child.(map[string]interface{}); ok {
+					walkSubschemasAt(childMap, fmt.Sprintf("%s/%s/%d", pointer, key, i), fn)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Diff describes the changes between two versions of a schema. Paths use the
+// dotted notation of PropertyPaths, with [] marking array items and the empty
+// path standing for the root.
+type Diff struct {
+	AddedProperties    []string           `json:"addedProperties,omitempty"`
+	RemovedProperties  []string           `json:"removedProperties,omitempty"`
+	AddedRequired      []string           `json:"addedRequired,omitempty"`
+	RemovedRequired    []string           `json:"removedRequired,omitempty"`
+	ChangedTypes       []TypeChange       `json:"changedTypes,omitempty"`
+	ChangedConstraints []ConstraintChange `json:"changedConstraints,omitempty"`
+}
+
+// TypeChange is a change of the type keyword of a schema
+type TypeChange struct {
+	Path string   `json:"path"`
+	Old  []string `json:"old,omitempty"`
+	New  []string `json:"new,omitempty"`
+}
+
+// ConstraintChange is a constraint keyword added, removed or changed. Old is
+// nil when the keyword was added and New is nil when it was removed.
+type ConstraintChange struct {
+	Path    string      `json:"path"`
+	Keyword string      `json:"keyword"`
+	Old     interface{} `json:"old,omitempty"`
+	New     interface{} `json:"new,omitempty"`
+}
+
+// Empty reports whether the schemas have no differences
+func (d *Diff) Empty() bool {
+	return len(d.AddedProperties) == 0 && len(d.RemovedProperties) == 0 &&
+		len(d.AddedRequired) == 0 && len(d.RemovedRequired) == 0 &&
+		len(d.ChangedTypes) == 0 && len(d.ChangedConstraints) == 0
+}
+
+// diffConstraintKeywords are the validation keywords compared between schema versions
+var diffConstraintKeywords = []string{
+	"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf",
+	"minLength", "maxLength", "pattern", "format", "enum", "const",
+	"minItems", "maxItems", "uniqueItems", "contains", "additionalItems",
+	"minProperties", "maxProperties", "additionalProperties", "patternProperties",
+	"propertyNames", "dependencies", "allOf", "anyOf", "oneOf", "not", "if", "then", "else",
+}
+
+// SchemaDiff compares two versions of a schema and reports the properties added
+// and removed, the required fields added and removed, and the types and
+// constraints changed. Local references are followed on both sides.
+func SchemaDiff(oldBytes, newBytes []byte) (*Diff, error) {
+	differ, err := diffSchemas(oldBytes, newBytes)
+	if err != nil {
+		return nil, err
+	}
+	return differ.diff, nil
+}
+
+// diffSchemas compares two schema versions and returns the differ holding the
+// sorted Diff along with the details only the compatibility check needs
+func diffSchemas(oldBytes, newBytes []byte) (*schemaDiffer, error) {
+	oldSchema, err := decodeSchemaForDiff(oldBytes)
+	if err != nil {
+		return nil, fmt.Errorf("schema antigo inválido: %w", err)
+	}
+	newSchema, err := decodeSchemaForDiff(newBytes)
+	if err != nil {
+		return nil, fmt.Errorf("schema novo inválido: %w", err)
+	}
+
+	diff := &Diff{}
+	differ := schemaDiffer{oldRoot: oldSchema, newRoot: newSchema, diff: diff, refs: make(map[string]bool)}
+	differ.compare("", oldSchema, newSchema)
+
+	sort.Strings(diff.AddedProperties)
+	sort.Strings(diff.RemovedProperties)
+	sort.Strings(diff.AddedRequired)
+	sort.Strings(diff.RemovedRequired)
+	sort.SliceStable(diff.ChangedTypes, func(i, j int) bool {
+		return diff.ChangedTypes[i].Path < diff.ChangedTypes[j].Path
+	})
+	sort.SliceStable(diff.ChangedConstraints, func(i, j int) bool {
+		a, b := diff.ChangedConstraints[i], diff.ChangedConstraints[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Keyword < b.Keyword
+	})
+
+	sort.Strings(differ.closedRemovals)
+
+	return &differ, nil
+}
+
+// decodeSchemaForDiff decodes a schema keeping numbers exactly as written
+func decodeSchemaForDiff(schemaBytes []byte) (map[string]interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(schemaBytes))
+	dec.UseNumber()
+
+	var schema map[string]interface{}
+	if err := dec.Decode(&schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// schemaDiffer walks two schema versions side by side
+type schemaDiffer struct {
+	oldRoot, newRoot map[string]interface{}
+	diff             *Diff
+	// refs holds the reference pairs being expanded on the current branch to stop cycles
+	refs map[string]bool
+	// closedRemovals holds the removed properties whose object no longer accepts additional properties
+	closedRemovals []string
+}
+
+func (d *schemaDiffer) compare(path string, oldSchema, newSchema map[string]interface{}) {
+	oldRef, _ := oldSchema["$ref"].(string)
+	newRef, _ := newSchema["$ref"].(string)
+	if oldRef != "" || newRef != "" {
+		key := oldRef + "|" + newRef
+		if d.refs[key] {
+			return
+		}
+		d.refs[key] = true
+		defer delete(d.refs, key)
+
+		if resolved, ok := resolveLocalRef(d.oldRoot, oldRef); ok {
+			oldSchema = resolved
+		}
+		if resolved, ok := resolveLocalRef(d.newRoot, newRef); ok {
+			newSchema = resolved
+		}
+	}
+
+	oldTypes, newTypes := typeList(oldSchema["type"]), typeList(newSchema["type"])
+	if !reflect.DeepEqual(oldTypes, newTypes) {
+		d.diff.ChangedTypes = append(d.diff.ChangedTypes, TypeChange{Path: path, Old: oldTypes, New: newTypes})
+	}
+
+	for _, keyword := range diffConstraintKeywords {
+		oldValue, newValue := oldSchema[keyword], newSchema[keyword]
+		if !reflect.DeepEqual(oldValue, newValue) {
+			d.diff.ChangedConstraints = append(d.diff.ChangedConstraints, ConstraintChange{
+				Path: path, Keyword: keyword, Old: oldValue, New: newValue,
+			})
+		}
+	}
+
+	oldRequired, newRequired := stringSet(oldSchema["required"]), stringSet(newSchema["required"])
+	for name := range newRequired {
+		if !oldRequired[name] {
+			d.diff.AddedRequired = append(d.diff.AddedRequired, joinFieldPath(path, name))
+		}
+	}
+	for name := range oldRequired {
+		if !newRequired[name] {
+			d.diff.RemovedRequired = append(d.diff.RemovedRequired, joinFieldPath(path, name))
+		}
+	}
+
+	oldProps, _ := oldSchema["properties"].(map[string]interface{})
+	newProps, _ := newSchema["properties"].(map[string]interface{})
+	for name, newProp := range newProps {
+		propPath := joinFieldPath(path, name)
+		oldProp, exists := oldProps[name]
+		if !exists {
+			d.diff.AddedProperties = append(d.diff.AddedProperties, propPath)
+			continue
+		}
+
+		oldMap, oldOK := oldProp.(map[string]interface{})
+		newMap, newOK := newProp.(map[string]interface{})
+		if oldOK && newOK {
+			d.compare(propPath, oldMap, newMap)
+		}
+	}
+	for name := range oldProps {
+		if _, exists := newProps[name]; !exists {
+			d.diff.RemovedProperties = append(d.diff.RemovedProperties, joinFieldPath(path, name))
+			if additional, ok := newSchema["additionalProperties"].(bool); ok && !additional {
+				d.closedRemovals = append(d.closedRemovals, joinFieldPath(path, name))
+			}
+		}
+	}
+
+	oldItems, oldOK := oldSchema["items"].(map[string]interface{})
+	newItems, newOK := newSchema["items"].(map[string]interface{})
+	if oldOK && newOK {
+		d.compare(path+"[]", oldItems, newItems)
+	} else if !reflect.DeepEqual(oldSchema["items"], newSchema["items"]) {
+		d.diff.ChangedConstraints = append(d.diff.ChangedConstraints, ConstraintChange{
+			Path: path, Keyword: "items", Old: oldSchema["items"], New: newSchema["items"],
+		})
+	}
+}
+
+// typeList returns the types declared by a type keyword, sorted
+func typeList(value interface{}) []string {
+	var types []string
+	switch typed := value.(type) {
+	case string:
+		types = append(types, typed)
+	case []interface{}:
+		for _, item := range typed {
+			if name, ok := item.(string); ok {
+				types = append(types, name)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// stringSet returns the strings of a JSON array as a set
+func stringSet(value interface{}) map[string]bool {
+	set := make(map[string]bool)
+	if items, ok := value.([]interface{}); ok {
+		for _, item := range items {
+			if str, ok := item.(string); ok {
+				set[str] = true
+			}
+		}
+	}
+	return set
+}
@@ -0,0 +1,125 @@
+package valid
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const diffOldSchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string", "maxLength": 50},
+		"age": {"type": "integer", "minimum": 0},
+		"nickname": {"type": "string"},
+		"address": {"$ref": "#/definitions/address"},
+		"tags": {"type": "array", "items": {"type": "string"}}
+	},
+	"required": ["name"],
+	"definitions": {
+		"address": {
+			"type": "object",
+			"properties": {"city": {"type": "string"}}
+		}
+	}
+}`
+
+const diffNewSchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string", "maxLength": 30},
+		"age": {"type": ["integer", "null"], "minimum": 0},
+		"email": {"type": "string", "format": "email"},
+		"address": {"$ref": "#/definitions/address"},
+		"tags": {"type": "array", "items": {"type": "string", "enum": ["a", "b"]}}
+	},
+	"required": ["name", "email"],
+	"definitions": {
+		"address": {
+			"type": "object",
+			"properties": {"city": {"type": "string"}, "zip": {"type": "string"}},
+			"required": ["zip"]
+		}
+	}
+}`
+
+func TestSchemaDiff(t *testing.T) {
+	diff, err := SchemaDiff([]byte(diffOldSchema), []byte(diffNewSchema))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	if expected := []string{"address.zip", "email"}; !reflect.DeepEqual(diff.AddedProperties, expected) {
+		t.Errorf("esperava propriedades adicionadas %v, recebeu %v", expected, diff.AddedProperties)
+	}
+	if expected := []string{"nickname"}; !reflect.DeepEqual(diff.RemovedProperties, expected) {
+		t.Errorf("esperava propriedades removidas %v, recebeu %v", expected, diff.RemovedProperties)
+	}
+	if expected := []string{"address.zip", "email"}; !reflect.DeepEqual(diff.AddedRequired, expected) {
+		t.Errorf("esperava obrigatórios adicionados %v, recebeu %v", expected, diff.AddedRequired)
+	}
+	if len(diff.RemovedRequired) != 0 {
+		t.Errorf("não esperava obrigatórios removidos, recebeu %v", diff.RemovedRequired)
+	}
+
+	if len(diff.ChangedTypes) != 1 || diff.ChangedTypes[0].Path != "age" ||
+		!reflect.DeepEqual(diff.ChangedTypes[0].New, []string{"integer", "null"}) {
+		t.Errorf("esperava mudança de tipo em 'age', recebeu %+v", diff.ChangedTypes)
+	}
+
+	changes := make(map[string]ConstraintChange)
+	for _, change := range diff.ChangedConstraints {
+		changes[change.Path+"|"+change.Keyword] = change
+	}
+	if len(changes) != 2 {
+		t.Errorf("esperava 2 restrições alteradas, recebeu %+v", diff.ChangedConstraints)
+	}
+	if change, ok := changes["name|maxLength"]; !ok || change.Old != json.Number("50") || change.New != json.Number("30") {
+		t.Errorf("esperava maxLength de 'name' alterado de 50 para 30, recebeu %+v", change)
+	}
+	if change, ok := changes["tags[]|enum"]; !ok || change.Old != nil {
+		t.Errorf("esperava enum adicionado em 'tags[]', recebeu %+v", change)
+	}
+}
+
+func TestSchemaDiffIdentical(t *testing.T) {
+	diff, err := SchemaDiff([]byte(diffOldSchema), []byte(diffOldSchema))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("esperava diff vazio para schemas iguais, recebeu %+v", diff)
+	}
+
+	output, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatalf("erro ao serializar diff: %v", err)
+	}
+	if string(output) != "{}" {
+		t.Errorf("esperava '{}', recebeu %s", output)
+	}
+}
+
+func TestSchemaDiffRecursiveRef(t *testing.T) {
+	schema := `{
+		"definitions": {"node": {"type": "object", "properties": {"child": {"$ref": "#/definitions/node"}}}},
+		"$ref": "#/definitions/node"
+	}`
+
+	diff, err := SchemaDiff([]byte(schema), []byte(schema))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("esperava diff vazio, recebeu %+v", diff)
+	}
+}
+
+func TestSchemaDiffInvalidJSON(t *testing.T) {
+	if _, err := SchemaDiff([]byte(`{`), []byte(`{}`)); err == nil {
+		t.Error("esperava erro para schema antigo inválido")
+	}
+	if _, err := SchemaDiff([]byte(`{}`), []byte(`not json`)); err == nil {
+		t.Error("esperava erro para schema novo inválido")
+	}
+}
@@ -0,0 +1,140 @@
+package valid
+
+// Direction indicates whether a document is being sent to or returned by the API
+type Direction int
+
+const (
+	// DirectionNone ignores the readOnly and writeOnly keywords (default)
+	DirectionNone Direction = iota
+	// DirectionWrite rejects documents that carry readOnly properties (requests)
+	DirectionWrite
+	// DirectionRead rejects documents that carry writeOnly properties (responses)
+	DirectionRead
+)
+
+// ValidateDirection validates JSON bytes honoring the readOnly/writeOnly keywords
+// for the given direction. Properties that must not be present are reported with
+// the readOnly or writeOnly constraint.
+func (v *Validator) ValidateDirection(data []byte, direction Direction) (*ValidationResult, error) {
+	return v.validateDirection(data, direction, false, false)
+}
+
+// validateDirection validates JSON bytes honoring the readOnly/writeOnly keywords
+// for the given direction. When partial is true, the required keywords are
+// ignored as in ValidatePartialWith, for partial updates such as PATCH.
+func (v *Validator) validateDirection(data []byte, direction Direction, partial, stripNested bool) (*ValidationResult, error) {
+	v = v.active()
+
+	var keyword string
+	switch direction {
+	case DirectionWrite:
+		keyword = "readOnly"
+	case DirectionRead:
+		keyword = "writeOnly"
+	default:
+		if partial {
+			return v.ValidatePartialWith(data, stripNested)
+		}
+		return v.ValidateBytes(data)
+	}
+
+	key := "direction-" + keyword
+	if partial && stripNested {
+		key = "partial-nested-" + key
+	} else if partial {
+		key = "partial-" + key
+	}
+
+	directional, _, err := v.derivedValidator(key, func(schema map[string]interface{}) interface{} {
+		if partial {
+			stripRequired(schema, stripNested)
+		}
+		forbidProperties(schema, keyword)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := directional.ValidateBytes(data)
+	if err != nil || result.Valid {
+		return result, err
+	}
+
+	// Properties rewritten to the false schema are reported by the keyword that
+	// forbids them, matched by their full path in the document
+	forbidden := v.forbiddenPaths(data, keyword)
+	for i, validationErr := range result.Errors {
+		if validationErr.Constraint != "false" || !forbidden[validationErr.Field] {
+			continue
+		}
+
+		result.Errors[i].Constraint = keyword
+		result.Errors[i].Code = codePrefix + keyword
+		if keyword == "readOnly" {
+			result.Errors[i].Message = "propriedade somente leitura não pode ser enviada"
+		} else {
+			result.Errors[i].Message = "propriedade somente escrita não pode ser retornada"
+		}
+	}
+
+	return result, nil
+}
+
+// forbidProperties replaces every property marked with keyword by the false
+// schema, so its presence fails validation, and removes it from the required
+// list of the same schema, as OpenAPI specifies, so documents can omit it
+func forbidProperties(schema map[string]interface{}, keyword string) {
+	walkSubschemas(schema, func(subschema map[string]interface{}) {
+		props, ok := subschema["properties"].(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		forbidden := make(map[string]bool)
+		for name, prop := range props {
+			propMap, ok := prop.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if flag, ok := propMap[keyword].(bool); ok && flag {
+				props[name] = false
+				forbidden[name] = true
+			}
+		}
+
+		if required, ok := subschema["required"].([]interface{}); ok && len(forbidden) > 0 {
+			kept := make([]interface{}, 0, len(required))
+			for _, name := range required {
+				if str, ok := name.(string); !ok || !forbidden[str] {
+					kept = append(kept, name)
+				}
+			}
+			if len(kept) == 0 {
+				delete(subschema, "required")
+			} else {
+				subschema["required"] = kept
+			}
+		}
+	})
+}
+
+// forbiddenPaths returns the field paths of the document values whose schema
+// is marked with keyword
+func (v *Validator) forbiddenPaths(data []byte, keyword string) map[string]bool {
+	paths := make(map[string]bool)
+
+	document, err := v.decodeDocument(data)
+	if err != nil {
+		return paths
+	}
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		for _, schema := range schemas {
+			if flag, ok := schema[keyword].(bool); ok && flag {
+				paths[path] = true
+			}
+		}
+	})
+	return paths
+}
@@ -0,0 +1,172 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const directionSchema = `{
+	"type": "object",
+	"properties": {
+		"id": {"type": "string", "readOnly": true},
+		"name": {"type": "string"},
+		"password": {"type": "string", "writeOnly": true},
+		"owner": {
+			"type": "object",
+			"properties": {
+				"id": {"type": "string", "readOnly": true},
+				"name": {"type": "string"}
+			}
+		}
+	},
+	"required": ["name"]
+}`
+
+func TestValidateDirection(t *testing.T) {
+	validator, err := NewFromString(directionSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		jsonData         string
+		direction        Direction
+		expectValid      bool
+		expectConstraint string
+	}{
+		{name: "write without readOnly", jsonData: `{"name": "Ana", "password": "s3cr3t"}`, direction: DirectionWrite, expectValid: true},
+		{name: "write with readOnly", jsonData: `{"id": "1", "name": "Ana"}`, direction: DirectionWrite, expectValid: false, expectConstraint: "readOnly"},
+		{name: "write with nested readOnly", jsonData: `{"name": "Ana", "owner": {"id": "2"}}`, direction: DirectionWrite, expectValid: false, expectConstraint: "readOnly"},
+		{name: "read with readOnly", jsonData: `{"id": "1", "name": "Ana"}`, direction: DirectionRead, expectValid: true},
+		{name: "read with writeOnly", jsonData: `{"name": "Ana", "password": "s3cr3t"}`, direction: DirectionRead, expectValid: false, expectConstraint: "writeOnly"},
+		{name: "no direction", jsonData: `{"id": "1", "name": "Ana", "password": "s3cr3t"}`, direction: DirectionNone, expectValid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateDirection([]byte(tt.jsonData), tt.direction)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Fatalf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+			if tt.expectConstraint != "" && result.Errors[0].Constraint != tt.expectConstraint {
+				t.Errorf("esperava constraint '%s', recebeu '%s'", tt.expectConstraint, result.Errors[0].Constraint)
+			}
+		})
+	}
+}
+
+func TestMiddlewareDirection(t *testing.T) {
+	validator, err := NewFromString(directionSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{Direction: DirectionWrite}, handler)
+
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"id": "1", "name": "Ana"}`))
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("esperava status 400, recebeu %d", w.Code)
+	}
+}
+
+func TestValidateDirectionRequired(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "readOnly": true},
+			"name": {"type": "string"},
+			"meta": {"type": "object", "properties": {"id": false}}
+		},
+		"required": ["id", "name"]
+	}`
+
+	validator, err := NewFromString(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	// A readOnly property is not required in requests
+	result, err := validator.ValidateDirection([]byte(`{"name": "a"}`), DirectionWrite)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava corpo de escrita válido sem a propriedade readOnly, recebeu %+v", result.Errors)
+	}
+
+	// but still required in responses
+	result, err = validator.ValidateDirection([]byte(`{"name": "a"}`), DirectionRead)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || result.Errors[0].Constraint != "required" {
+		t.Errorf("esperava erro de required na leitura, recebeu %+v", result.Errors)
+	}
+
+	// A field with the same name at another depth keeps its own error
+	result, err = validator.ValidateDirection([]byte(`{"id": "1", "name": "a", "meta": {"id": 2}}`), DirectionWrite)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	constraints := make(map[string]string)
+	for _, validationErr := range result.Errors {
+		constraints[validationErr.Field] = validationErr.Constraint
+	}
+	if constraints["id"] != "readOnly" || constraints["meta.id"] != "false" {
+		t.Errorf("esperava readOnly em id e false em meta.id, recebeu %v", constraints)
+	}
+}
+
+func TestMiddlewareDirectionPartial(t *testing.T) {
+	validator, err := NewFromString(directionSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		Direction:      DirectionWrite,
+		PartialMethods: []string{"PATCH"},
+	}, handler)
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{name: "readOnly field in patch", body: `{"id": "2"}`, expectedStatus: http.StatusBadRequest},
+		{name: "nested readOnly field in patch", body: `{"owner": {"id": "2"}}`, expectedStatus: http.StatusBadRequest},
+		{name: "patch without required name", body: `{"password": "secret"}`, expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("PATCH", "/test", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			middleware(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("esperava status %d, recebeu %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.expectedStatus == http.StatusBadRequest && !strings.Contains(w.Body.String(), "readOnly") {
+				t.Errorf("esperava erro readOnly, recebeu %s", w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// withoutFormats returns the schema bytes with the given formats removed from
+// every subschema, so gojsonschema does not check them
+func withoutFormats(schemaBytes []byte, formats []string) ([]byte, error) {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		return nil, fmt.Errorf("schema JSON inválido: %w", err)
+	}
+
+	disabled := formatSet(formats)
+	walkSubschemas(schema, func(subschema map[string]interface{}) {
+		if format, ok := subschema["format"].(string); ok && disabled[format] {
+			delete(subschema, "format")
+		}
+	})
+
+	return json.Marshal(schema)
+}
+
+// checkDisabledFormats reports, with the RejectDisabledFormats option, every
+// string whose schema declares a disabled format
+func (v *Validator) checkDisabledFormats(document interface{}) []ValidationError {
+	var errs []ValidationError
+	disabled := formatSet(v.opts.DisabledFormats)
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		if _, ok := value.(string); !ok {
+			return
+		}
+
+		for _, schema := range schemas {
+			if format, ok := schema["format"].(string); ok && disabled[format] {
+				errs = append(errs, v.completeError(ValidationError{
+					Message:    fmt.Sprintf("o formato '%s' está desativado", format),
+					Value:      value,
+					Constraint: "format",
+				}, path))
+				return
+			}
+		}
+	})
+
+	sortErrorsByField(errs)
+	return errs
+}
+
+// formatSet returns the set of the given format names
+func formatSet(formats []string) map[string]bool {
+	set := make(map[string]bool, len(formats))
+	for _, format := range formats {
+		set[format] = true
+	}
+	return set
+}
@@ -0,0 +1,70 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDisabledFormats(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"site": {"type": "string", "format": "uri"},
+			"email": {"type": "string", "format": "email"}
+		}
+	}`)
+
+	tests := []struct {
+		name   string
+		opts   Options
+		data   string
+		valid  bool
+		fields []string
+	}{
+		{"formato ativo", Options{}, `{"site": "não é uri", "email": "ana@x.com"}`, false, []string{"site"}},
+		{"formato desativado ignora valor", Options{DisabledFormats: []string{"uri"}}, `{"site": "não é uri", "email": "ana@x.com"}`, true, nil},
+		{"outros formatos seguem ativos", Options{DisabledFormats: []string{"uri"}}, `{"site": "https://x.com", "email": "invalido"}`, false, []string{"email"}},
+		{"formato desativado rejeita", Options{DisabledFormats: []string{"uri"}, RejectDisabledFormats: true}, `{"site": "https://x.com", "email": "ana@x.com"}`, false, []string{"site"}},
+		{"rejeição apenas de strings presentes", Options{DisabledFormats: []string{"uri"}, RejectDisabledFormats: true}, `{"email": "ana@x.com"}`, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewFromBytesWithOptions(schema, tt.opts)
+			if err != nil {
+				t.Fatalf("erro ao criar validator: %v", err)
+			}
+
+			result, err := validator.ValidateString(tt.data)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.valid || len(result.Errors) != len(tt.fields) {
+				t.Fatalf("esperava Valid=%v com erros em %v, recebeu %+v", tt.valid, tt.fields, result.Errors)
+			}
+			for i, field := range tt.fields {
+				if result.Errors[i].Field != field || result.Errors[i].Constraint != "format" {
+					t.Errorf("esperava erro de formato em '%s', recebeu %+v", field, result.Errors[i])
+				}
+			}
+		})
+	}
+
+	validator, err := NewFromBytesWithOptions(schema, Options{DisabledFormats: []string{"uri"}, RejectDisabledFormats: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, _ := validator.ValidateString(`{"site": "https://x.com"}`)
+	if !strings.Contains(result.Errors[0].Message, "o formato 'uri' está desativado") {
+		t.Errorf("mensagem inesperada '%s'", result.Errors[0].Message)
+	}
+
+	// Disabled formats need no checker under StrictFormats
+	unknown := []byte(`{"properties": {"doc": {"format": "cnpj"}}}`)
+	if _, err := NewFromBytesWithOptions(unknown, Options{StrictFormats: true}); err == nil {
+		t.Error("esperava erro de formato desconhecido com StrictFormats")
+	}
+	if _, err := NewFromBytesWithOptions(unknown, Options{StrictFormats: true, DisabledFormats: []string{"cnpj"}}); err != nil {
+		t.Errorf("não esperava erro para formato desativado, recebeu %v", err)
+	}
+}
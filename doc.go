@@ -100,6 +100,58 @@ Middleware with custom settings:
 
 http.HandleFunc("/users", validator.MiddlewareWithConfig(config, userHandler))
 
+The same settings can be given as functional options:
+
+	mw := validator.MiddlewareFunc(
+		validator.WithSkipMethods("GET", "DELETE"),
+		validator.WithMaxBodyBytes(1 << 20),
+	)
+
+http.HandleFunc("/users", mw(userHandler))
+
+Request headers can be validated with a second validator. Only the headers
+named in its top-level properties are selected; since HTTP header names are
+case-insensitive, "x-api-version" in the schema reads the X-Api-Version header,
+and the value is keyed as the schema spells it. A header sent more than once
+becomes an array of strings:
+
+	headers, _ := validator.NewFromString(`{
+		"type": "object",
+		"properties": {"X-Api-Version": {"enum": ["2024-01", "2025-01"]}},
+		"required": ["X-Api-Version"]
+	}`)
+
+	config := validator.MiddlewareConfig{HeaderSchema: headers}
+
+On GraphQL endpoints, GraphQLVariables validates only the "variables" member
+of the body (or GraphQLVariablesField). The query and operationName members
+are neither validated nor changed, and the handler receives the body intact.
+
+# WebSocket Messages
+
+Validate inbound WebSocket frames. Non-text frames (binary, ping, pong, close)
+are ignored and return a nil result. With gorilla/websocket:
+
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		result, err := validator.ValidateMessage(messageType, data)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		if result != nil && !result.Valid {
+			conn.WriteJSON(map[string]interface{}{"errors": result.Errors})
+			continue
+		}
+
+		// Process valid message...
+	}
+
 # Multiple Validators
 
 For applications with multiple endpoints and different schemas:
@@ -127,6 +179,19 @@ userValidator, exists := multiValidator.Get("user")
 		// ...
 	}
 
+For union payloads, ValidateAny returns the key of the schema the data
+matches, or of the closest one with its errors; SetMatchStrategy chooses
+between the first match and the match with the fewest violations:
+
+	key, result, err := multiValidator.ValidateAny(payload)
+
+For versioned APIs, MiddlewareByVersion validates each request with the
+validator registered under its version, read from a vendor media type in the
+Accept header or from a custom header:
+
+	versioned := multiValidator.MiddlewareByVersion(validator.VersionFromAccept)
+	http.HandleFunc("/users", versioned(createUser))
+
 # Data Structures
 
 ValidationResult represents the result of a validation:
@@ -143,6 +208,130 @@ ValidationError provides details about validation errors:
 		Message string `json:"message"` // Error message
 		Value interface{} `json:"value,omitempty"` // Value that caused the error
 		Constraint string `json:"constraint,omitempty"` // Type of constraint violated
+		Code string `json:"code,omitempty"` // Stable machine-readable code (e.g. validation.minLength)
+	}
+
+Codes follow the validation.<keyword> pattern and can be overridden per field with
+the errorCode keyword, either as a string or as an object keyed by constraint:
+
+	"email": {"type": "string", "format": "email", "errorCode": "user.email.invalid"}
+
+# Per-call Options
+
+The *Context methods accept options that apply to a single call:
+
+	result, err := validator.ValidateBytesContext(ctx, data, valid.MaxErrors(5), valid.CoerceTypes(true))
+
+FailFast, MaxErrors and CoerceTypes may also be set in Options when the validator
+is built. Call-level options take precedence over the constructor-level ones for
+that call only; the remaining Options apply to every call.
+
+# Normalization
+
+With the Normalize option, string values marked with x-trim, x-lowercase or
+x-uppercase are normalized before validation and the normalized document is
+returned in ValidationResult.Normalized:
+
+	"email": {"type": "string", "format": "email", "x-trim": true, "x-lowercase": true}
+
+Constraints such as format: email, pattern and maxLength apply to the normalized
+value, so " Joao@Example.com " is accepted and validated as "joao@example.com".
+
+String enums marked with x-enum-case-insensitive accept any casing of their
+values, and the normalized document holds the value as written in the enum:
+
+	"role": {"enum": ["customer", "supplier"], "x-enum-case-insensitive": true}
+
+accepts "Customer" and normalizes it to "customer".
+
+# XML Documents
+
+ValidateXML converts XML to JSON before validating it, so the schema describes
+the converted shape: the content of the root element, attributes as "@name",
+child elements by local name (arrays when repeated), text as a string or under
+"#text" next to attributes and children. Values are strings unless the
+CoerceTypes option converts them:
+
+	<user id="7"><name>Ana</name><tag>a</tag><tag>b</tag></user>
+
+becomes
+
+	{"@id": "7", "name": "Ana", "tag": ["a", "b"]}
+
+# OpenAPI Components
+
+NewFromOpenAPI builds a validator from a components/schemas entry of an
+OpenAPI 3 spec in JSON, resolving $refs to the other component schemas and
+converting nullable, example, discriminator and deprecated to Draft 7:
+
+	validator, err := validator.NewFromOpenAPI(specBytes, "Order")
+
+# Custom Keywords
+
+Keywords gojsonschema does not know are checked after the standard validation
+by the functions registered with RegisterKeyword. x-unique-by ships built in and
+rejects arrays whose objects repeat the value of a property:
+
+	"items": {"type": "array", "x-unique-by": "sku"}
+
+A custom keyword is registered once, usually in an init function:
+
+	valid.RegisterKeyword("x-even-count", func(value, param interface{}, path string) []valid.ValidationError {
+		if items, ok := value.([]interface{}); ok && len(items)%2 != 0 {
+			return []valid.ValidationError{{Message: "a lista deve ter um número par de itens"}}
+		}
+		return nil
+	})
+
+# Cross-field Rules
+
+x-greater-than and x-equal-to compare a property with a sibling property of the
+same object, ordering numbers, dates and strings:
+
+	"endDate": {"type": "string", "format": "date", "x-greater-than": "startDate"}
+
+Other relations are added in Go with AddCrossFieldRule, which receives the
+decoded document after schema validation:
+
+	validator.AddCrossFieldRule("stateRequiredForBR", func(doc map[string]interface{}) []valid.ValidationError {
+		if doc["country"] == "BR" && doc["state"] == nil {
+			return []valid.ValidationError{{Field: "state", Fields: []string{"state", "country"}, Message: "informe o estado"}}
+		}
+		return nil
+	})
+
+# Message Templates
+
+Custom errorMessage strings may use placeholders filled in from the violation:
+
+	"name": {
+		"type": "string",
+		"minLength": 3,
+		"errorMessage": {"minLength": "{field} deve ter ao menos {limit} caracteres (recebido: {value})"}
+	}
+
+{field} is the dotted path of the field (of the missing property for required)
+and {value} the offending value, omitted with the RedactValues option. {limit}
+depends on the constraint:
+
+	minLength, minimum, exclusiveMinimum, minItems, minProperties  the lower bound
+	maxLength, maximum, exclusiveMaximum, maxItems, maxProperties  the upper bound
+	multipleOf                                                     the divisor
+	pattern                                                        the pattern
+	format                                                         the format name
+	enum, const                                                    the allowed values
+	type                                                           the expected type
+	dependencies                                                   the required property
+
+# Schema Linting
+
+LintSchema flags schemas that are valid JSON but behave wrongly, such as a
+minimum greater than the maximum, required properties missing from properties,
+unknown formats and patterns that do not compile. It returns structured
+warnings, so a CI step can fail on them:
+
+	for _, warning := range validator.LintSchema(schemaBytes) {
+		fmt.Printf("%s %s: %s\n", warning.Path, warning.Keyword, warning.Message)
 	}
 
 # Error Handling
@@ -152,6 +341,14 @@ The library differentiates between validation errors (invalid data) and operatio
 - Operational errors (file not found, malformed JSON, etc.) are returned as error
 - Invalid data results in ValidationResult.Valid = false with details in ValidationResult.Errors
 
+Handlers that decode the body themselves can validate and decode it in one call
+with BindAndValidate, which writes the standard error response on failure:
+
+	user, ok := validator.BindAndValidate[User](w, r, userValidator)
+	if !ok {
+		return
+	}
+
 # Compatibility
 
 This library is compatible with JSON Schema Draft 7 and supports all its versions Features:
@@ -0,0 +1,22 @@
+package valid
+
+import "strings"
+
+// addDocsURLs sets the DocsURL of every error of the result
+func addDocsURLs(result *ValidationResult, baseURL string) {
+	for i := range result.Errors {
+		result.Errors[i].DocsURL = baseURL + "#" + docsAnchor(result.Errors[i])
+	}
+}
+
+// docsAnchor returns the documentation anchor of an error, made of the field
+// path and the constraint keyword, such as email-format or address-zipcode-pattern
+func docsAnchor(validationErr ValidationError) string {
+	keyword := constraintKeyword(validationErr.Constraint)
+	if validationErr.Field == "" {
+		return strings.ToLower(keyword)
+	}
+
+	field := strings.ReplaceAll(validationErr.Field, ".", "-")
+	return strings.ToLower(field + "-" + keyword)
+}
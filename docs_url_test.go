@@ -0,0 +1,48 @@
+package valid
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareDocsBaseURL(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		DocsBaseURL: "https://docs.example.com/users",
+	}, func(w http.ResponseWriter, r *http.Request) {})
+
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "Ana", "email": "invalido", "address": {"street": "A", "city": "B", "zipCode": "x"}}`))
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	var errorResponse ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&errorResponse); err != nil {
+		t.Fatalf("erro ao decodificar resposta de erro: %v", err)
+	}
+
+	links := make(map[string]string)
+	for _, detail := range errorResponse.Details {
+		links[detail.Field] = detail.DocsURL
+	}
+	if links["email"] != "https://docs.example.com/users#email-format" {
+		t.Errorf("link inesperado para 'email': '%s'", links["email"])
+	}
+	if links["address.zipCode"] != "https://docs.example.com/users#address-zipcode-pattern" {
+		t.Errorf("link inesperado para 'address.zipCode': '%s'", links["address.zipCode"])
+	}
+
+	// Without the option no link is emitted
+	plain := validator.Middleware(func(w http.ResponseWriter, r *http.Request) {})
+	w = httptest.NewRecorder()
+	plain(w, httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "Ana", "email": "invalido"}`)))
+	if strings.Contains(w.Body.String(), "docsUrl") {
+		t.Errorf("não esperava links sem DocsBaseURL, recebeu %s", w.Body.String())
+	}
+}
@@ -0,0 +1,25 @@
+package valid
+
+import "fmt"
+
+// checkDocumentSize returns a failed result when the document is larger than
+// the MaxDocumentBytes option, or nil otherwise. It runs before any parsing.
+func (v *Validator) checkDocumentSize(jsonData []byte) *ValidationResult {
+	if v.opts.MaxDocumentBytes <= 0 || len(jsonData) <= v.opts.MaxDocumentBytes {
+		return nil
+	}
+
+	return &ValidationResult{
+		Valid: false,
+		Errors: []ValidationError{
+			{
+				Field:      "",
+				Message:    fmt.Sprintf("documento excede o tamanho máximo de %d bytes (%d bytes)", v.opts.MaxDocumentBytes, len(jsonData)),
+				Value:      len(jsonData),
+				Constraint: "maxDocumentBytes",
+				Code:       codePrefix + "maxDocumentBytes",
+				Severity:   SeverityError,
+			},
+		},
+	}
+}
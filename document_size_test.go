@@ -0,0 +1,53 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaxDocumentBytes(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(`{"type": "object"}`), Options{MaxDocumentBytes: 32})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "Ana"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava documento dentro do limite válido, recebeu %+v", result.Errors)
+	}
+
+	oversized := `{"name": "` + strings.Repeat("a", 64) + `"}`
+	checks := map[string]func() (*ValidationResult, error){
+		"ValidateString": func() (*ValidationResult, error) { return validator.ValidateString(oversized) },
+		"ValidateBytes":  func() (*ValidationResult, error) { return validator.ValidateBytes([]byte(oversized)) },
+		"ValidateInterface": func() (*ValidationResult, error) {
+			return validator.ValidateInterface(map[string]string{"name": strings.Repeat("a", 64)})
+		},
+		"PooledValidate": func() (*ValidationResult, error) { return validator.PooledValidate([]byte(oversized)) },
+	}
+
+	for name, check := range checks {
+		result, err := check()
+		if err != nil {
+			t.Fatalf("%s: não esperava erro, mas recebeu: %v", name, err)
+		}
+		if result.Valid || len(result.Errors) != 1 {
+			t.Fatalf("%s: esperava um erro de tamanho, recebeu %+v", name, result.Errors)
+		}
+		if validationErr := result.Errors[0]; validationErr.Constraint != "maxDocumentBytes" || validationErr.Code != codePrefix+"maxDocumentBytes" {
+			t.Errorf("%s: erro inesperado %+v", name, validationErr)
+		}
+	}
+
+	// Oversized documents are rejected before parsing, even when malformed
+	result, err = validator.ValidateString(`{"name": ` + strings.Repeat("[", 64))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Constraint != "maxDocumentBytes" {
+		t.Errorf("esperava erro de tamanho antes do JSON inválido, recebeu %+v", result.Errors)
+	}
+}
@@ -0,0 +1,72 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// findDuplicateKeys scans the document token by token and reports every key
+// repeated within the same object
+func (v *Validator) findDuplicateKeys(jsonData []byte) ([]ValidationError, error) {
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.UseNumber()
+
+	var errs []ValidationError
+	if err := v.scanDuplicateKeys(dec, "", &errs); err != nil {
+		return nil, fmt.Errorf("erro ao verificar chaves duplicadas: %w", err)
+	}
+	return errs, nil
+}
+
+// scanDuplicateKeys consumes one JSON value from dec, recording duplicate keys found in it
+func (v *Validator) scanDuplicateKeys(dec *json.Decoder, path string, errs *[]ValidationError) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return nil
+	}
+
+	switch delim {
+	case '{':
+		seen := make(map[string]bool)
+		for dec.More() {
+			keyToken, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key := keyToken.(string)
+			field := joinFieldPath(path, key)
+
+			if seen[key] {
+				*errs = append(*errs, ValidationError{
+					Field:      field,
+					Message:    fmt.Sprintf("chave duplicada: '%s'", key),
+					Value:      key,
+					Constraint: "duplicateKey",
+					Code:       v.errorCode(field, "duplicateKey"),
+				})
+			}
+			seen[key] = true
+
+			if err := v.scanDuplicateKeys(dec, field, errs); err != nil {
+				return err
+			}
+		}
+	case '[':
+		for i := 0; dec.More(); i++ {
+			if err := v.scanDuplicateKeys(dec, joinFieldPath(path, strconv.Itoa(i)), errs); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Consumes the closing delimiter
+	_, err = dec.Token()
+	return err
+}
@@ -0,0 +1,46 @@
+package valid
+
+import "testing"
+
+func TestRejectDuplicateKeys(t *testing.T) {
+	payload := `{"name": "Ana", "email": "ana@test.com", "name": "Maria", "address": {"city": "A", "city": "B", "street": "C"}}`
+
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err := validator.ValidateString(payload)
+	if err != nil || !result.Valid {
+		t.Errorf("esperava documento válido sem RejectDuplicateKeys, recebeu %+v, %v", result, err)
+	}
+
+	validator, err = NewFromBytesWithOptions([]byte(testSchema), Options{RejectDuplicateKeys: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err = validator.ValidateString(payload)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Fatal("esperava documento inválido por chaves duplicadas")
+	}
+
+	fields := make(map[string]bool)
+	for _, validationErr := range result.Errors {
+		if validationErr.Constraint != "duplicateKey" {
+			t.Errorf("esperava constraint 'duplicateKey', recebeu '%s'", validationErr.Constraint)
+		}
+		fields[validationErr.Field] = true
+	}
+	if !fields["name"] || !fields["address.city"] {
+		t.Errorf("esperava duplicatas em 'name' e 'address.city', recebeu %+v", result.Errors)
+	}
+
+	// Same key in different objects is not a duplicate
+	result, err = validator.ValidateString(`{"name": "Ana", "email": "ana@test.com", "address": {"name": "x", "street": "a", "city": "b"}}`)
+	if err != nil || !result.Valid {
+		t.Errorf("esperava documento válido, recebeu %+v, %v", result, err)
+	}
+}
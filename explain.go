@@ -0,0 +1,265 @@
+package valid
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Explanation describes which fields of a document were covered by the schema
+type Explanation struct {
+	Result    *ValidationResult  `json:"result"`
+	Covered   []FieldExplanation `json:"covered,omitempty"`
+	Uncovered []string           `json:"uncovered,omitempty"`
+}
+
+// FieldExplanation describes the constraints evaluated for a document field
+type FieldExplanation struct {
+	Field       string   `json:"field"`
+	Constraints []string `json:"constraints,omitempty"`
+	Passed      bool     `json:"passed"`
+}
+
+// annotationKeywords are schema keywords that do not constrain the value itself
+var annotationKeywords = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true,
+	"default": true, "examples": true, "definitions": true, "$defs": true,
+	"errorMessage": true, "readOnly": true, "writeOnly": true,
+}
+
+// Explain validates JSON bytes and reports, for every field present in the
+// document, the schema constraints that applied to it and whether they passed.
+// Fields present in the document without any matching schema rule are listed as uncovered.
+func (v *Validator) Explain(data []byte) (*Explanation, error) {
+	v = v.active()
+
+	result, err := v.ValidateBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &Explanation{Result: result}
+
+	document, err := v.decodeDocument(data)
+	if err != nil {
+		// Malformed documents are already reported in the result
+		return explanation, nil
+	}
+
+	failed := make(map[string]bool, len(result.Errors))
+	for _, validationErr := range result.Errors {
+		failed[validationErr.Field] = true
+	}
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		if path != "" {
+			recordField(path, schemas, failed, explanation)
+		}
+	})
+
+	sort.Slice(explanation.Covered, func(i, j int) bool {
+		return explanation.Covered[i].Field < explanation.Covered[j].Field
+	})
+	sort.Strings(explanation.Uncovered)
+
+	return explanation, nil
+}
+
+// recordField registers a field as covered or uncovered
+func recordField(path string, schemas []map[string]interface{}, failed map[string]bool, explanation *Explanation) {
+	if len(schemas) == 0 {
+		explanation.Uncovered = append(explanation.Uncovered, path)
+		return
+	}
+
+	explanation.Covered = append(explanation.Covered, FieldExplanation{
+		Field:       path,
+		Constraints: constraintKeywords(schemas),
+		Passed:      !failed[path],
+	})
+}
+
+// constraintKeywords lists the constraint keywords declared by the given schemas
+func constraintKeywords(schemas []map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	keywords := make([]string, 0)
+
+	for _, schema := range schemas {
+		for keyword := range schema {
+			if annotationKeywords[keyword] || strings.HasPrefix(keyword, "x-") || seen[keyword] {
+				continue
+			}
+			seen[keyword] = true
+			keywords = append(keywords, keyword)
+		}
+	}
+
+	sort.Strings(keywords)
+	return keywords
+}
+
+// propertySchemas returns the schemas that apply to a property of an object
+func propertySchemas(root map[string]interface{}, schemas []map[string]interface{}, key string) []map[string]interface{} {
+	return propertySchemasWith(schemas, key, func(schema map[string]interface{}) []map[string]interface{} {
+		return expandSchema(root, schema)
+	})
+}
+
+// propertySchemasWith is like propertySchemas, expanding each matched schema with expand
+func propertySchemasWith(schemas []map[string]interface{}, key string, expand func(schema map[string]interface{}) []map[string]interface{}) []map[string]interface{} {
+	var matched []map[string]interface{}
+
+	for _, schema := range schemas {
+		found := false
+
+		if props, ok := schema["properties"].(map[string]interface{}); ok {
+			if prop, ok := props[key].(map[string]interface{}); ok {
+				matched = append(matched, expand(prop)...)
+				found = true
+			}
+		}
+
+		if patterns, ok := schema["patternProperties"].(map[string]interface{}); ok {
+			for pattern, prop := range patterns {
+				re, err := compiledPattern(pattern)
+				if err != nil || !re.MatchString(key) {
+					continue
+				}
+				if propMap, ok := prop.(map[string]interface{}); ok {
+					matched = append(matched, expand(propMap)...)
+					found = true
+				}
+			}
+		}
+
+		if !found {
+			if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+				matched = append(matched, expand(additional)...)
+			}
+		}
+	}
+
+	return matched
+}
+
+// itemSchemas returns the schemas that apply to the element at index of an array
+func itemSchemas(root map[string]interface{}, schemas []map[string]interface{}, index int) []map[string]interface{} {
+	return itemSchemasWith(schemas, index, func(schema map[string]interface{}) []map[string]interface{} {
+		return expandSchema(root, schema)
+	})
+}
+
+// itemSchemasWith is like itemSchemas, expanding each matched schema with expand
+func itemSchemasWith(schemas []map[string]interface{}, index int, expand func(schema map[string]interface{}) []map[string]interface{}) []map[string]interface{} {
+	var matched []map[string]interface{}
+
+	for _, schema := range schemas {
+		switch items := schema["items"].(type) {
+		case map[string]interface{}:
+			matched = append(matched, expand(items)...)
+		case []interface{}:
+			if index < len(items) {
+				if itemMap, ok := items[index].(map[string]interface{}); ok {
+					matched = append(matched, expand(itemMap)...)
+				}
+			} else if additional, ok := schema["additionalItems"].(map[string]interface{}); ok {
+				matched = append(matched, expand(additional)...)
+			}
+		}
+	}
+
+	return matched
+}
+
+// expandSchema resolves local references and returns the schema together with
+// the branches of its composition keywords, whatever the value. The checks
+// performed on a document use appliedSchemas, which keeps only the anyOf and
+// oneOf branches the value matches.
+func expandSchema(root, schema map[string]interface{}) []map[string]interface{} {
+	return expandSchemaDepth(root, schema, 0)
+}
+
+// maxExpandDepth guards against recursive references
+const maxExpandDepth = 32
+
+func expandSchemaDepth(root, schema map[string]interface{}, depth int) []map[string]interface{} {
+	if schema == nil || depth > maxExpandDepth {
+		return nil
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		if resolved, ok := resolveLocalRef(root, ref); ok {
+			return expandSchemaDepth(root, resolved, depth+1)
+		}
+	}
+
+	expanded := []map[string]interface{}{schema}
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		if branches, ok := schema[keyword].([]interface{}); ok {
+			for _, branch := range branches {
+				if branchMap, ok := branch.(map[string]interface{}); ok {
+					expanded = append(expanded, expandSchemaDepth(root, branchMap, depth+1)...)
+				}
+			}
+		}
+	}
+
+	return expanded
+}
+
+// resolveLocalRef resolves a same-document reference such as "#/definitions/Address"
+func resolveLocalRef(root map[string]interface{}, ref string) (map[string]interface{}, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+
+	node, ok := resolvePointer(root, strings.TrimPrefix(ref, "#"))
+	if !ok {
+		return nil, false
+	}
+
+	schema, ok := node.(map[string]interface{})
+	return schema, ok
+}
+
+// resolvePointer resolves a JSON Pointer (RFC 6901) within a decoded document
+func resolvePointer(document interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return document, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+
+	node := document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch typed := node.(type) {
+		case map[string]interface{}:
+			child, ok := typed[token]
+			if !ok {
+				return nil, false
+			}
+			node = child
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			node = typed[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return node, true
+}
+
+// joinFieldPath appends a segment to a dotted field path
+func joinFieldPath(path, segment string) string {
+	if path == "" {
+		return segment
+	}
+	return path + "." + segment
+}
@@ -0,0 +1,66 @@
+package valid
+
+import "testing"
+
+func TestExplain(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	explanation, err := validator.Explain([]byte(`{
+		"name": "J",
+		"email": "j@example.com",
+		"nickname": "jj",
+		"address": {"street": "Rua A", "city": "Recife", "country": "BR"}
+	}`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	if explanation.Result == nil || explanation.Result.Valid {
+		t.Error("esperava resultado inválido por causa do nome curto")
+	}
+
+	covered := make(map[string]FieldExplanation)
+	for _, field := range explanation.Covered {
+		covered[field.Field] = field
+	}
+
+	name, ok := covered["name"]
+	if !ok {
+		t.Fatal("campo 'name' deveria estar coberto")
+	}
+	if name.Passed {
+		t.Error("campo 'name' não deveria ter passado")
+	}
+	if !containsString(name.Constraints, "minLength") {
+		t.Errorf("esperava constraint minLength para 'name', recebeu %v", name.Constraints)
+	}
+
+	if email, ok := covered["email"]; !ok || !email.Passed {
+		t.Error("campo 'email' deveria estar coberto e válido")
+	}
+	if _, ok := covered["address.city"]; !ok {
+		t.Error("campo 'address.city' deveria estar coberto")
+	}
+
+	expectedUncovered := []string{"address.country", "nickname"}
+	if len(explanation.Uncovered) != len(expectedUncovered) {
+		t.Fatalf("esperava campos não cobertos %v, recebeu %v", expectedUncovered, explanation.Uncovered)
+	}
+	for i, field := range expectedUncovered {
+		if explanation.Uncovered[i] != field {
+			t.Errorf("esperava campo não coberto '%s', recebeu '%s'", field, explanation.Uncovered[i])
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package valid
+
+import "strings"
+
+// FormError is a validation error addressed to an HTML form input
+type FormError struct {
+	// Name is the name of the form input, empty for errors of the whole document
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// FormNameStyle selects how field paths are converted to form input names
+type FormNameStyle int
+
+const (
+	// FormNameBracket names inputs in bracket notation, e.g. address[zipCode] and items[0][sku]
+	FormNameBracket FormNameStyle = iota
+	// FormNameDotted keeps the dotted field path, e.g. address.zipCode and items.0.sku
+	FormNameDotted
+)
+
+// ToFormErrors returns the errors of the result as form errors, naming the
+// inputs in bracket notation
+func (vr *ValidationResult) ToFormErrors() []FormError {
+	return vr.ToFormErrorsWith(FormNameBracket)
+}
+
+// ToFormErrorsWith returns the errors of the result as form errors, naming the
+// inputs in the given style
+func (vr *ValidationResult) ToFormErrorsWith(style FormNameStyle) []FormError {
+	formErrors := make([]FormError, 0, len(vr.Errors))
+	for _, validationErr := range vr.Errors {
+		name := validationErr.Field
+		if style == FormNameBracket {
+			name = bracketName(name)
+		}
+		formErrors = append(formErrors, FormError{Name: name, Message: validationErr.Message})
+	}
+	return formErrors
+}
+
+// bracketName converts a dotted field path into bracket notation
+func bracketName(field string) string {
+	segments := strings.Split(field, ".")
+	if len(segments) == 1 {
+		return field
+	}
+	return segments[0] + "[" + strings.Join(segments[1:], "][") + "]"
+}
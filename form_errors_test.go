@@ -0,0 +1,34 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToFormErrors(t *testing.T) {
+	result := &ValidationResult{Errors: []ValidationError{
+		{Field: "name", Message: "muito curto"},
+		{Field: "address.zipCode", Message: "formato inválido"},
+		{Field: "items.0.sku", Message: "obrigatório"},
+		{Field: "", Message: "propriedade adicional"},
+	}}
+
+	expected := []FormError{
+		{Name: "name", Message: "muito curto"},
+		{Name: "address[zipCode]", Message: "formato inválido"},
+		{Name: "items[0][sku]", Message: "obrigatório"},
+		{Name: "", Message: "propriedade adicional"},
+	}
+	if formErrors := result.ToFormErrors(); !reflect.DeepEqual(formErrors, expected) {
+		t.Errorf("esperava %+v, recebeu %+v", expected, formErrors)
+	}
+
+	dotted := result.ToFormErrorsWith(FormNameDotted)
+	if dotted[1].Name != "address.zipCode" || dotted[2].Name != "items.0.sku" {
+		t.Errorf("esperava nomes com pontos, recebeu %+v", dotted)
+	}
+
+	if formErrors := (&ValidationResult{Valid: true}).ToFormErrors(); formErrors == nil || len(formErrors) != 0 {
+		t.Errorf("esperava lista vazia para resultado válido, recebeu %#v", formErrors)
+	}
+}
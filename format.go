@@ -0,0 +1,156 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// FormatOptions configures the text rendering of a validation result
+type FormatOptions struct {
+	// Color highlights severities and field names with ANSI escape codes
+	Color bool
+	// Verbose renders each error as a block including code, severity and value,
+	// instead of a single line
+	Verbose bool
+}
+
+const (
+	ansiReset  = "\033[0m"
+	ansiBold   = "\033[1m"
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+)
+
+// Format renders the result as human-readable text, suitable for command-line
+// output. The JSON serialization of the result is not affected.
+func (vr *ValidationResult) Format(w io.Writer, opts FormatOptions) error {
+	var b strings.Builder
+
+	if len(vr.Errors) == 0 {
+		b.WriteString(colorize(opts, ansiGreen, "válido"))
+		b.WriteString("\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	status := colorize(opts, ansiRed, "inválido")
+	if vr.Valid {
+		status = colorize(opts, ansiYellow, "válido com avisos")
+	}
+	fmt.Fprintf(&b, "%s: %d erro(s)\n", status, len(vr.Errors))
+
+	for _, validationErr := range vr.Errors {
+		if opts.Verbose {
+			formatVerbose(&b, validationErr, opts)
+		} else {
+			formatCompact(&b, validationErr, opts)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatCompact renders an error in a single line
+func formatCompact(b *strings.Builder, validationErr ValidationError, opts FormatOptions) {
+	fmt.Fprintf(b, "  %s %s: %s (%s)\n",
+		severityLabel(validationErr.Severity, opts),
+		colorize(opts, ansiBold, displayField(validationErr.Field)),
+		validationErr.Message,
+		validationErr.Constraint)
+}
+
+// formatVerbose renders an error as an indented block
+func formatVerbose(b *strings.Builder, validationErr ValidationError, opts FormatOptions) {
+	fmt.Fprintf(b, "\n  %s %s\n", severityLabel(validationErr.Severity, opts), colorize(opts, ansiBold, displayField(validationErr.Field)))
+	fmt.Fprintf(b, "    mensagem:   %s\n", validationErr.Message)
+	fmt.Fprintf(b, "    restrição:  %s\n", validationErr.Constraint)
+	if validationErr.Code != "" {
+		fmt.Fprintf(b, "    código:     %s\n", validationErr.Code)
+	}
+	fmt.Fprintf(b, "    ponteiro:   %s\n", fieldPointer(validationErr.Field))
+	if validationErr.Value != nil {
+		value, err := json.Marshal(validationErr.Value)
+		if err != nil {
+			value = []byte(fmt.Sprint(validationErr.Value))
+		}
+		fmt.Fprintf(b, "    valor:      %s\n", value)
+	}
+}
+
+// severityLabel returns the tag shown before each error
+func severityLabel(severity Severity, opts FormatOptions) string {
+	if severity == SeverityWarning {
+		return colorize(opts, ansiYellow, "[aviso]")
+	}
+	return colorize(opts, ansiRed, "[erro]")
+}
+
+// displayField returns the field name shown to the user, naming the root explicitly
+func displayField(field string) string {
+	if field == "" {
+		return "(root)"
+	}
+	return field
+}
+
+// fieldPointer converts a dotted field path into a JSON Pointer (RFC 6901)
+func fieldPointer(field string) string {
+	if field == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, segment := range strings.Split(field, ".") {
+		b.WriteString("/")
+		b.WriteString(escapePointerToken(segment))
+	}
+	return b.String()
+}
+
+// defaultHumanPathSeparator separates the segments of a human path unless the
+// HumanPathSeparator option sets another one
+const defaultHumanPathSeparator = " → "
+
+// humanPath renders a dotted field path for end users, joining the properties
+// with separator and attaching array indices to the property they belong to,
+// e.g. "items[0] → sku"
+func humanPath(field, separator string) string {
+	if field == "" {
+		return ""
+	}
+	if separator == "" {
+		separator = defaultHumanPathSeparator
+	}
+
+	var b strings.Builder
+	for i, segment := range strings.Split(field, ".") {
+		switch {
+		case isArrayIndex(segment):
+			b.WriteString("[" + segment + "]")
+		case i > 0:
+			b.WriteString(separator)
+			fallthrough
+		default:
+			b.WriteString(segment)
+		}
+	}
+	return b.String()
+}
+
+// escapePointerToken escapes a JSON Pointer reference token (RFC 6901)
+func escapePointerToken(token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	return strings.ReplaceAll(token, "/", "~1")
+}
+
+// colorize wraps text in the given ANSI code when color is enabled
+func colorize(opts FormatOptions, code, text string) string {
+	if !opts.Color {
+		return text
+	}
+	return code + text + ansiReset
+}
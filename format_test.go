@@ -0,0 +1,122 @@
+package valid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestValidationResultFormat(t *testing.T) {
+	result := &ValidationResult{
+		Valid: false,
+		Errors: []ValidationError{
+			{Field: "items.0.email", Message: "Does not match format 'email'", Value: "x", Constraint: "format", Code: "validation.format", Severity: SeverityError},
+			{Field: "", Message: "name is required", Constraint: "required", Severity: SeverityError},
+		},
+	}
+
+	var compact bytes.Buffer
+	if err := result.Format(&compact, FormatOptions{}); err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	output := compact.String()
+	if !strings.Contains(output, "inválido: 2 erro(s)") {
+		t.Errorf("esperava cabeçalho com a contagem de erros, recebeu:\n%s", output)
+	}
+	if !strings.Contains(output, "[erro] items.0.email: Does not match format 'email' (format)") {
+		t.Errorf("esperava linha compacta do erro, recebeu:\n%s", output)
+	}
+	if !strings.Contains(output, "(root): name is required") {
+		t.Errorf("esperava a raiz nomeada explicitamente, recebeu:\n%s", output)
+	}
+	if strings.Contains(output, "\033[") {
+		t.Error("não esperava códigos de cor sem a opção Color")
+	}
+
+	var verbose bytes.Buffer
+	result.Format(&verbose, FormatOptions{Verbose: true, Color: true})
+	output = verbose.String()
+	for _, expected := range []string{"ponteiro:   /items/0/email", "código:     validation.format", `valor:      "x"`, ansiRed} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("esperava '%s' na saída detalhada, recebeu:\n%s", expected, output)
+		}
+	}
+
+	var valid bytes.Buffer
+	(&ValidationResult{Valid: true}).Format(&valid, FormatOptions{})
+	if valid.String() != "válido\n" {
+		t.Errorf("esperava 'válido', recebeu '%s'", valid.String())
+	}
+}
+
+func TestFieldPointer(t *testing.T) {
+	cases := map[string]string{
+		"":          "",
+		"name":      "/name",
+		"items.0.a": "/items/0/a",
+		"a/b":       "/a~1b",
+		"m~n":       "/m~0n",
+	}
+	for field, expected := range cases {
+		if pointer := fieldPointer(field); pointer != expected {
+			t.Errorf("fieldPointer(%q) = %q, esperava %q", field, pointer, expected)
+		}
+	}
+}
+
+func TestHumanPath(t *testing.T) {
+	cases := []struct {
+		field     string
+		separator string
+		expected  string
+	}{
+		{field: "", expected: ""},
+		{field: "name", expected: "name"},
+		{field: "address.zipCode", expected: "address → zipCode"},
+		{field: "items.0.sku", expected: "items[0] → sku"},
+		{field: "0.name", expected: "[0] → name"},
+		{field: "matrix.1.2", expected: "matrix[1][2]"},
+		{field: "address.zipCode", separator: " / ", expected: "address / zipCode"},
+	}
+	for _, tc := range cases {
+		if path := humanPath(tc.field, tc.separator); path != tc.expected {
+			t.Errorf("humanPath(%q, %q) = %q, esperava %q", tc.field, tc.separator, path, tc.expected)
+		}
+	}
+}
+
+func TestValidationErrorHumanPath(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"address": {"type": "object", "properties": {"zipCode": {"type": "string", "pattern": "^[0-9]{5}$"}}}
+		}
+	}`
+
+	validator, err := NewFromString(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err := validator.ValidateString(`{"address": {"zipCode": "abc"}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].HumanPath != "address → zipCode" {
+		t.Errorf("esperava caminho 'address → zipCode', recebeu %+v", result.Errors)
+	}
+	if result.Errors[0].Context != "(root).address.zipCode" {
+		t.Errorf("esperava o contexto do gojsonschema preservado, recebeu '%s'", result.Errors[0].Context)
+	}
+
+	validator, err = NewFromBytesWithOptions([]byte(schema), Options{HumanPathSeparator: " > "})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err = validator.ValidateString(`{"address": {"zipCode": "abc"}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].HumanPath != "address > zipCode" {
+		t.Errorf("esperava caminho 'address > zipCode', recebeu %+v", result.Errors)
+	}
+}
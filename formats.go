@@ -0,0 +1,112 @@
+package valid
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// builtinFormats are the formats recognized by gojsonschema out of the box
+var builtinFormats = []string{
+	"date", "date-time", "email", "hostname", "idn-email", "ipv4", "ipv6", "iri",
+	"iri-reference", "json-pointer", "regex", "relative-json-pointer", "time",
+	"uri", "uri-reference", "uri-template", "uuid",
+}
+
+// checkFormats returns an error listing every format used by the schema that
+// has no registered checker, other than the disabled ones
+func checkFormats(schema map[string]interface{}, disabled []string) error {
+	unknown := make(map[string]bool)
+	skipped := formatSet(disabled)
+
+	walkSubschemas(schema, func(subschema map[string]interface{}) {
+		if format, ok := subschema["format"].(string); ok && !skipped[format] && !gojsonschema.FormatCheckers.Has(format) {
+			unknown[format] = true
+		}
+	})
+
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return fmt.Errorf("formatos desconhecidos no schema: %s (formatos reconhecidos: %s)",
+		strings.Join(names, ", "), strings.Join(knownFormats(), ", "))
+}
+
+// knownFormats returns the built-in formats and those added with RegisterFormat, sorted
+func knownFormats() []string {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+
+	names := append(make([]string, 0, len(builtinFormats)+len(registeredFormats)), builtinFormats...)
+	for name := range registeredFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FormatFunc reports whether input satisfies a custom format. Non-string inputs
+// are usually accepted, as JSON Schema formats only apply to strings.
+type FormatFunc func(input interface{}) bool
+
+// IsFormat implements gojsonschema.FormatChecker
+func (f FormatFunc) IsFormat(input interface{}) bool {
+	return f(input)
+}
+
+var (
+	formatsMu         sync.Mutex
+	registeredFormats = make(map[string]bool)
+)
+
+// RegisterFormat registers a checker for a custom format, used by every
+// validator. It returns an error when the name is already taken, by a built-in
+// format or by another registration, instead of silently replacing it.
+func RegisterFormat(name string, fn FormatFunc) error {
+	if name == "" || fn == nil {
+		return fmt.Errorf("nome e função do formato são obrigatórios")
+	}
+
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+
+	if gojsonschema.FormatCheckers.Has(name) {
+		return fmt.Errorf("formato '%s' já está registrado", name)
+	}
+
+	gojsonschema.FormatCheckers.Add(name, fn)
+	registeredFormats[name] = true
+	return nil
+}
+
+// MustRegisterFormat is like RegisterFormat but panics on conflict. It is meant
+// for registrations done in init functions.
+func MustRegisterFormat(name string, fn FormatFunc) {
+	if err := RegisterFormat(name, fn); err != nil {
+		panic(err)
+	}
+}
+
+// UnregisterFormat removes a format registered with RegisterFormat, mostly for
+// test cleanup. Built-in formats are never removed.
+func UnregisterFormat(name string) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+
+	if !registeredFormats[name] {
+		return
+	}
+
+	gojsonschema.FormatCheckers.Remove(name)
+	delete(registeredFormats, name)
+}
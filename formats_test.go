@@ -0,0 +1,101 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStrictFormats(t *testing.T) {
+	typoSchema := []byte(`{
+		"type": "object",
+		"properties": {
+			"email": {"type": "string", "format": "emial"},
+			"contacts": {"type": "array", "items": {"type": "string", "format": "hostnam"}}
+		}
+	}`)
+
+	// Without the option the typo is silently accepted
+	validator, err := NewFromBytes(typoSchema)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	result, err := validator.ValidateString(`{"email": "não é email"}`)
+	if err != nil || !result.Valid {
+		t.Errorf("esperava documento válido sem StrictFormats, recebeu %+v, %v", result, err)
+	}
+
+	_, err = NewFromBytesWithOptions(typoSchema, Options{StrictFormats: true})
+	if err == nil {
+		t.Fatal("esperava erro para formato desconhecido")
+	}
+	for _, expected := range []string{"emial", "hostnam", "email"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("mensagem de erro deveria conter '%s': %v", expected, err)
+		}
+	}
+
+	_, err = NewFromBytesWithOptions([]byte(testSchema), Options{StrictFormats: true})
+	if err != nil {
+		t.Errorf("não esperava erro para formatos conhecidos, recebeu: %v", err)
+	}
+
+	// Registered formats are listed among the recognized ones
+	if err := RegisterFormat("strict-formats-test", func(interface{}) bool { return true }); err != nil {
+		t.Fatalf("erro ao registrar formato: %v", err)
+	}
+	defer UnregisterFormat("strict-formats-test")
+
+	_, err = NewFromBytesWithOptions(typoSchema, Options{StrictFormats: true})
+	if err == nil || !strings.Contains(err.Error(), "strict-formats-test") {
+		t.Errorf("esperava o formato registrado entre os reconhecidos, recebeu: %v", err)
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	isCPF := func(input interface{}) bool {
+		str, ok := input.(string)
+		if !ok {
+			return true
+		}
+		return len(str) == 11
+	}
+
+	if err := RegisterFormat("cpf", isCPF); err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	defer UnregisterFormat("cpf")
+
+	// Registering the same name again is a conflict
+	if err := RegisterFormat("cpf", isCPF); err == nil {
+		t.Error("esperava erro ao registrar formato já existente")
+	}
+	if err := RegisterFormat("email", isCPF); err == nil {
+		t.Error("esperava erro ao sobrescrever formato nativo")
+	}
+
+	validator, err := NewFromBytesWithOptions([]byte(`{"type": "string", "format": "cpf"}`), Options{StrictFormats: true})
+	if err != nil {
+		t.Fatalf("formato registrado deveria ser aceito com StrictFormats: %v", err)
+	}
+	result, _ := validator.ValidateString(`"123"`)
+	if result.Valid {
+		t.Error("esperava documento inválido para o formato registrado")
+	}
+
+	// Unregistering frees the name, but never removes built-in formats
+	UnregisterFormat("cpf")
+	UnregisterFormat("email")
+	if err := RegisterFormat("cpf", isCPF); err != nil {
+		t.Errorf("esperava registrar novamente após UnregisterFormat: %v", err)
+	}
+	if err := RegisterFormat("email", isCPF); err == nil {
+		t.Error("formato nativo não deveria ser removido por UnregisterFormat")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("esperava panic em MustRegisterFormat com conflito")
+		}
+	}()
+	MustRegisterFormat("cpf", isCPF)
+}
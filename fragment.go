@@ -0,0 +1,35 @@
+package valid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateAtPointer validates data against the subschema the JSON Pointer
+// resolves to within the loaded schema, such as "#/definitions/Address" or
+// "/definitions/Address". References inside the subschema keep resolving
+// against the whole schema.
+func (v *Validator) ValidateAtPointer(pointer string, data []byte) (*ValidationResult, error) {
+	v = v.active()
+
+	ref := "#" + strings.TrimPrefix(pointer, "#")
+	if _, ok := resolveLocalRef(v.schemaObj, ref); !ok {
+		return nil, fmt.Errorf("ponteiro '%s' não aponta para um schema no documento", pointer)
+	}
+
+	if ref == "#" {
+		return v.ValidateBytes(data)
+	}
+
+	// A root $ref makes gojsonschema ignore the sibling keywords, while the
+	// rest of the document stays available for references
+	fragment, _, err := v.derivedValidator("pointer:"+ref, func(schema map[string]interface{}) interface{} {
+		schema["$ref"] = ref
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fragment.ValidateBytes(data)
+}
@@ -0,0 +1,59 @@
+package valid
+
+import "testing"
+
+const fragmentSchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string"},
+		"address": {"$ref": "#/definitions/Address"}
+	},
+	"required": ["name", "address"],
+	"definitions": {
+		"Address": {
+			"type": "object",
+			"properties": {
+				"street": {"type": "string"},
+				"zip": {"$ref": "#/definitions/Zip"}
+			},
+			"required": ["street"]
+		},
+		"Zip": {"type": "string", "pattern": "^[0-9]{5}-?[0-9]{3}$"}
+	}
+}`
+
+func TestValidateAtPointer(t *testing.T) {
+	validator, err := NewFromString(fragmentSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateAtPointer("#/definitions/Address", []byte(`{"street": "Rua A", "zip": "01000-000"}`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava endereço válido, recebeu %+v", result.Errors)
+	}
+
+	// Nested references resolve against the whole schema
+	result, err = validator.ValidateAtPointer("/definitions/Address", []byte(`{"zip": "abc"}`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || !result.HasFieldError("zip") {
+		t.Errorf("esperava erros de required e pattern, recebeu %+v", result.Errors)
+	}
+
+	// The root pointer validates against the whole schema
+	result, _ = validator.ValidateAtPointer("#", []byte(`{"street": "Rua A"}`))
+	if result.Valid {
+		t.Error("esperava documento inválido contra o schema completo")
+	}
+
+	for _, pointer := range []string{"#/definitions/Missing", "#/required", "definitions/Address"} {
+		if _, err := validator.ValidateAtPointer(pointer, []byte(`{}`)); err == nil {
+			t.Errorf("esperava erro para o ponteiro '%s'", pointer)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ValidateGoValue validates a Go value against the schema without the JSON
+// marshal/unmarshal round trip of ValidateInterface when possible.
+//
+// Generic JSON trees, built from map[string]interface{}, []interface{}, string,
+// bool, nil, json.Number and Go numeric types, are handed to gojsonschema
+// directly, with numbers converted to json.Number. Any other value (structs,
+// typed maps or slices, time.Time, types implementing json.Marshaler) goes
+// through ValidateInterface, because only encoding/json knows how it is
+// represented in JSON: field names from tags, omitempty, custom MarshalJSON
+// output and RFC 3339 timestamps. Non-finite floats (NaN, ±Inf) are rejected
+// with an error, as json.Marshal would do.
+//
+// Validators using options that work on the JSON bytes (ResultCacheSize,
+// MaxDocumentBytes, MaxDepth, CoerceTypes, Normalize, CaptureRaw) also go
+// through ValidateInterface, so every option behaves as in ValidateBytes.
+func (v *Validator) ValidateGoValue(data interface{}) (*ValidationResult, error) {
+	v = v.active()
+
+	if v.results != nil || v.opts.MaxDocumentBytes > 0 || v.opts.MaxDepth > 0 || v.opts.CoerceTypes || v.opts.Normalize || v.opts.CaptureRaw {
+		return v.ValidateInterface(data)
+	}
+
+	var start time.Time
+	if v.opts.MeasureTiming {
+		start = time.Now()
+	}
+
+	document, ok, err := normalizeGoValue(data)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return v.ValidateInterface(data)
+	}
+
+	result, err := v.runSchema(gojsonschema.NewRawLoader(document))
+	if err != nil {
+		return nil, err
+	}
+
+	validationResult := v.buildValidationResult(result)
+	if v.needsDocument() {
+		// The post-validation checks see numbers as decodeDocument produces them
+		if !v.usesNumberDocument() {
+			document = floatDocument(document)
+		}
+		v.postValidateDocument(document, validationResult)
+	}
+
+	v.finalizeResult(validationResult)
+	limitErrors(validationResult, v.callOptions(nil).maxErrors)
+
+	if v.opts.MeasureTiming {
+		validationResult.Duration = time.Since(start)
+	}
+
+	return validationResult, nil
+}
+
+// normalizeGoValue converts a generic JSON tree to the representation used by
+// gojsonschema, returning ok=false when the value holds any other type
+func normalizeGoValue(value interface{}) (interface{}, bool, error) {
+	switch typed := value.(type) {
+	case nil, string, bool, json.Number:
+		return typed, true, nil
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(typed))
+		for key, child := range typed {
+			childValue, ok, err := normalizeGoValue(child)
+			if !ok || err != nil {
+				return nil, ok, err
+			}
+			normalized[key] = childValue
+		}
+		return normalized, true, nil
+	case []interface{}:
+		normalized := make([]interface{}, len(typed))
+		for i, child := range typed {
+			childValue, ok, err := normalizeGoValue(child)
+			if !ok || err != nil {
+				return nil, ok, err
+			}
+			normalized[i] = childValue
+		}
+		return normalized, true, nil
+	case float64:
+		return floatNumber(typed, 64)
+	case float32:
+		return floatNumber(float64(typed), 32)
+	case int:
+		return json.Number(strconv.FormatInt(int64(typed), 10)), true, nil
+	case int8:
+		return json.Number(strconv.FormatInt(int64(typed), 10)), true, nil
+	case int16:
+		return json.Number(strconv.FormatInt(int64(typed), 10)), true, nil
+	case int32:
+		return json.Number(strconv.FormatInt(int64(typed), 10)), true, nil
+	case int64:
+		return json.Number(strconv.FormatInt(typed, 10)), true, nil
+	case uint:
+		return json.Number(strconv.FormatUint(uint64(typed), 10)), true, nil
+	case uint8:
+		return json.Number(strconv.FormatUint(uint64(typed), 10)), true, nil
+	case uint16:
+		return json.Number(strconv.FormatUint(uint64(typed), 10)), true, nil
+	case uint32:
+		return json.Number(strconv.FormatUint(uint64(typed), 10)), true, nil
+	case uint64:
+		return json.Number(strconv.FormatUint(typed, 10)), true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// floatNumber converts a float to json.Number, rejecting values JSON cannot represent
+func floatNumber(f float64, bitSize int) (interface{}, bool, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, true, fmt.Errorf("erro ao serializar dados para JSON: valor numérico não finito %v", f)
+	}
+	return json.Number(strconv.FormatFloat(f, 'g', -1, bitSize)), true, nil
+}
+
+// floatDocument copies a tree with json.Number values into one with float64
+// values, as encoding/json decodes numbers without UseNumber
+func floatDocument(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case json.Number:
+		f, _ := strconv.ParseFloat(string(typed), 64)
+		return f
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(typed))
+		for key, child := range typed {
+			converted[key] = floatDocument(child)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, child := range typed {
+			converted[i] = floatDocument(child)
+		}
+		return converted
+	default:
+		return typed
+	}
+}
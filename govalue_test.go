@@ -0,0 +1,177 @@
+package valid
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestValidateGoValue(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		data        interface{}
+		expectValid bool
+		expectError bool
+	}{
+		{
+			name: "valid generic map",
+			data: map[string]interface{}{
+				"name":    "Test User",
+				"email":   "test@example.com",
+				"age":     25,
+				"address": map[string]interface{}{"street": "Rua A", "city": "Recife"},
+			},
+			expectValid: true,
+		},
+		{
+			name:        "float that is an integer",
+			data:        map[string]interface{}{"name": "Test", "email": "test@example.com", "age": float64(30)},
+			expectValid: true,
+		},
+		{
+			name:        "fractional age",
+			data:        map[string]interface{}{"name": "Test", "email": "test@example.com", "age": 30.5},
+			expectValid: false,
+		},
+		{
+			name:        "invalid generic map",
+			data:        map[string]interface{}{"name": "T", "email": "invalid-email"},
+			expectValid: false,
+		},
+		{
+			name: "struct falls back to marshaling",
+			data: struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			}{Name: "Test", Email: "test@example.com"},
+			expectValid: true,
+		},
+		{
+			name:        "non finite number",
+			data:        map[string]interface{}{"name": "Test", "email": "test@example.com", "age": math.Inf(1)},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateGoValue(tt.data)
+			if tt.expectError {
+				if err == nil {
+					t.Error("esperava erro, mas não recebeu nenhum")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Errorf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+		})
+	}
+
+	// time.Time is only representable through its MarshalJSON
+	timeValidator, err := NewFromString(`{"type": "object", "properties": {"at": {"type": "string", "format": "date-time"}}}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err := timeValidator.ValidateGoValue(map[string]interface{}{"at": time.Now()})
+	if err != nil || !result.Valid {
+		t.Errorf("esperava time.Time válido, recebeu %+v, %v", result, err)
+	}
+}
+
+func BenchmarkValidateInterface(b *testing.B) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		b.Fatalf("erro ao criar validator: %v", err)
+	}
+	data := benchmarkGoValue()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := validator.ValidateInterface(data); err != nil {
+			b.Fatalf("erro durante benchmark: %v", err)
+		}
+	}
+}
+
+func BenchmarkValidateGoValue(b *testing.B) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		b.Fatalf("erro ao criar validator: %v", err)
+	}
+	data := benchmarkGoValue()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := validator.ValidateGoValue(data); err != nil {
+			b.Fatalf("erro durante benchmark: %v", err)
+		}
+	}
+}
+
+func benchmarkGoValue() map[string]interface{} {
+	return map[string]interface{}{
+		"name":  "João Silva",
+		"email": "joao@exemplo.com",
+		"age":   30,
+		"address": map[string]interface{}{
+			"street":  "Rua das Flores, 123",
+			"city":    "São Paulo",
+			"zipCode": "01234-567",
+		},
+	}
+}
+
+func TestValidateGoValueMatchesValidateBytes(t *testing.T) {
+	// The keyword rejects values that are not float64, as decoded without UseNumber
+	err := RegisterKeyword("x-float-only", func(value, param interface{}, path string) []ValidationError {
+		if _, ok := value.(float64); !ok {
+			return []ValidationError{{Field: path, Message: "esperava float64", Constraint: "x-float-only"}}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("erro ao registrar palavra-chave: %v", err)
+	}
+
+	schema := `{"type": "object", "properties": {"amount": {"type": "number", "x-float-only": true}, "nested": {"type": "object"}}}`
+	data := map[string]interface{}{"amount": 10, "nested": map[string]interface{}{"deep": []interface{}{1}}}
+	jsonData := []byte(`{"amount": 10, "nested": {"deep": [1]}}`)
+
+	for name, opts := range map[string]Options{
+		"padrão":     {},
+		"MaxDepth":   {MaxDepth: 2},
+		"CaptureRaw": {CaptureRaw: true},
+	} {
+		validator, err := NewFromBytesWithOptions([]byte(schema), opts)
+		if err != nil {
+			t.Fatalf("%s: erro ao criar validator: %v", name, err)
+		}
+
+		fromBytes, err := validator.ValidateBytes(jsonData)
+		if err != nil {
+			t.Fatalf("%s: não esperava erro, mas recebeu: %v", name, err)
+		}
+		fromValue, err := validator.ValidateGoValue(data)
+		if err != nil {
+			t.Fatalf("%s: não esperava erro, mas recebeu: %v", name, err)
+		}
+
+		if fromValue.Valid != fromBytes.Valid || len(fromValue.Errors) != len(fromBytes.Errors) {
+			t.Errorf("%s: ValidateGoValue %+v difere de ValidateBytes %+v", name, fromValue.Errors, fromBytes.Errors)
+		}
+		if (fromValue.Raw == nil) != (fromBytes.Raw == nil) {
+			t.Errorf("%s: Raw difere entre ValidateGoValue e ValidateBytes", name)
+		}
+	}
+}
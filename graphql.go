@@ -0,0 +1,40 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// graphQLVariables returns the variables object of a GraphQL request body,
+// which is what the schema describes. The query, operationName and any other
+// member of the body are left untouched and are not validated. Absent or null
+// variables are validated as an empty object, so required variables are
+// reported. Malformed JSON is returned as is for the validation to report it.
+func graphQLVariables(data []byte, field string) ([]byte, *ValidationResult) {
+	if !json.Valid(data) {
+		return data, nil
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, &ValidationResult{
+			Valid: false,
+			Errors: []ValidationError{
+				{
+					Field:      "root",
+					Message:    fmt.Sprintf("o corpo da requisição GraphQL deve ser um objeto com o campo '%s'", field),
+					Constraint: "type",
+					Code:       codePrefix + "type",
+					Severity:   SeverityError,
+				},
+			},
+		}
+	}
+
+	variables, ok := body[field]
+	if !ok || bytes.Equal(bytes.TrimSpace(variables), []byte("null")) {
+		return []byte("{}"), nil
+	}
+	return variables, nil
+}
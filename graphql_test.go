@@ -0,0 +1,60 @@
+package valid
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareGraphQLVariables(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {"id": {"type": "string", "minLength": 3}},
+		"required": ["id"],
+		"additionalProperties": false
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	query := `"query": "query User($id: ID!) { user(id: $id) { name } }", "operationName": "User"`
+	tests := []struct {
+		name   string
+		field  string
+		body   string
+		status int
+	}{
+		{"variáveis válidas", "", `{` + query + `, "variables": {"id": "abc"}}`, http.StatusOK},
+		{"variáveis inválidas", "", `{` + query + `, "variables": {"id": "a"}}`, http.StatusBadRequest},
+		{"variáveis ausentes", "", `{` + query + `}`, http.StatusBadRequest},
+		{"variáveis nulas", "", `{` + query + `, "variables": null}`, http.StatusBadRequest},
+		{"campo personalizado", "vars", `{` + query + `, "vars": {"id": "abc"}}`, http.StatusOK},
+		{"corpo não objeto", "", `[{"variables": {"id": "abc"}}]`, http.StatusBadRequest},
+		{"JSON inválido", "", `{"variables": `, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var forwarded string
+			middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+				GraphQLVariables:      true,
+				GraphQLVariablesField: tt.field,
+			}, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				forwarded = string(body)
+			})
+
+			w := httptest.NewRecorder()
+			middleware(w, httptest.NewRequest("POST", "/graphql", strings.NewReader(tt.body)))
+
+			if w.Code != tt.status {
+				t.Errorf("esperava status %d, recebeu %d: %s", tt.status, w.Code, w.Body.String())
+			}
+			if tt.status == http.StatusOK && forwarded != tt.body {
+				t.Errorf("esperava o corpo GraphQL intacto no handler, recebeu %s", forwarded)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Package grpcvalid provides gRPC interceptors that validate JSON-encoded payloads
+// carried inside gRPC messages against JSON Schemas. It is a separate module, so
+// the core validator package does not depend on gRPC.
+package grpcvalid
+
+import (
+	"context"
+	"fmt"
+
+	valid "github.com/raywall/json-schema-validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExtractFunc pulls the JSON bytes and the schema key from a gRPC request.
+// Returning empty bytes skips validation for the request.
+type ExtractFunc func(req interface{}) ([]byte, string)
+
+// UnaryServerInterceptor returns a unary server interceptor that validates the
+// JSON payload extracted from each request with the matching validator of mv.
+// Invalid payloads are rejected with codes.InvalidArgument and the validation
+// errors attached as a BadRequest detail.
+func UnaryServerInterceptor(mv *valid.MultiValidator, extract ExtractFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		data, key := extract(req)
+		if len(data) == 0 {
+			return handler(ctx, req)
+		}
+
+		validator, exists := mv.Get(key)
+		if !exists {
+			return nil, status.Errorf(codes.Internal, "schema '%s' não registrado", key)
+		}
+
+		result, err := validator.ValidateBytesContext(ctx, data)
+		if err != nil {
+			// A canceled or expired RPC is reported as such, not as a validation failure
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, status.FromContextError(ctxErr).Err()
+			}
+			return nil, status.Errorf(codes.Internal, "erro interno de validação: %s", err.Error())
+		}
+
+		if !result.Valid {
+			return nil, invalidArgument(result)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// invalidArgument builds an InvalidArgument status carrying the validation errors
+func invalidArgument(result *valid.ValidationResult) error {
+	st := status.New(codes.InvalidArgument, "Dados de entrada inválidos")
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(result.Errors))
+	for _, validationErr := range result.Errors {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       validationErr.Field,
+			Description: validationErr.Message,
+		})
+	}
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Dados de entrada inválidos: %d erro(s)", len(result.Errors)))
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,118 @@
+package grpcvalid
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	valid "github.com/raywall/json-schema-validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type detailsRequest struct {
+	Details string
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	mv := valid.NewMultiValidator()
+	if err := mv.AddFromString("details", `{
+		"type": "object",
+		"properties": {"name": {"type": "string", "minLength": 2}},
+		"required": ["name"]
+	}`); err != nil {
+		t.Fatalf("erro ao adicionar validator: %v", err)
+	}
+
+	interceptor := UnaryServerInterceptor(mv, func(req interface{}) ([]byte, string) {
+		return []byte(req.(*detailsRequest).Details), "details"
+	})
+
+	handlerCalled := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	// Valid payload reaches the handler
+	_, err := interceptor(context.Background(), &detailsRequest{Details: `{"name": "Ana"}`}, info, handler)
+	if err != nil {
+		t.Errorf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !handlerCalled {
+		t.Error("handler deveria ter sido chamado para dados válidos")
+	}
+
+	// Invalid payload is rejected with InvalidArgument
+	handlerCalled = false
+	_, err = interceptor(context.Background(), &detailsRequest{Details: `{"name": "A"}`}, info, handler)
+	if handlerCalled {
+		t.Error("handler não deveria ter sido chamado para dados inválidos")
+	}
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("esperava código InvalidArgument, recebeu %v", err)
+	}
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = br
+		}
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) == 0 {
+		t.Fatal("esperava detalhes BadRequest com violações")
+	}
+	if badRequest.FieldViolations[0].Field != "name" {
+		t.Errorf("esperava violação no campo 'name', recebeu '%s'", badRequest.FieldViolations[0].Field)
+	}
+
+	// Unknown schema key
+	unknown := UnaryServerInterceptor(mv, func(req interface{}) ([]byte, string) {
+		return []byte(`{}`), "inexistente"
+	})
+	_, err = unknown(context.Background(), &detailsRequest{}, info, handler)
+	if status.Code(err) != codes.Internal {
+		t.Errorf("esperava código Internal para schema inexistente, recebeu %v", err)
+	}
+}
+
+func TestUnaryServerInterceptorContext(t *testing.T) {
+	mv := valid.NewMultiValidator()
+	if err := mv.AddFromString("details", `{"type": "object"}`); err != nil {
+		t.Fatalf("erro ao adicionar validator: %v", err)
+	}
+
+	interceptor := UnaryServerInterceptor(mv, func(req interface{}) ([]byte, string) {
+		return []byte(req.(*detailsRequest).Details), "details"
+	})
+
+	handlerCalled := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCalled = true
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := interceptor(canceled, &detailsRequest{Details: `{}`}, info, handler)
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("esperava código Canceled, recebeu %v", err)
+	}
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	_, err = interceptor(expired, &detailsRequest{Details: `{}`}, info, handler)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("esperava código DeadlineExceeded, recebeu %v", err)
+	}
+
+	if handlerCalled {
+		t.Error("handler não deveria ter sido chamado para RPCs cancelados")
+	}
+}
@@ -0,0 +1,33 @@
+package valid
+
+import "net/http"
+
+// validateHeaders validates the request headers declared by the header schema.
+//
+// Only the headers named in the schema's top-level properties are selected.
+// HTTP header names are case-insensitive, so each property is looked up in its
+// canonical form (x-api-version and X-API-VERSION both read X-Api-Version) and
+// the value is stored under the property name exactly as the schema spells it.
+// A header sent once becomes a string, a repeated header an array of strings.
+func validateHeaders(headers *Validator, r *http.Request) (*ValidationResult, error) {
+	headers = headers.active()
+
+	document := make(map[string]interface{})
+	properties, _ := headers.schemaObj["properties"].(map[string]interface{})
+	for name := range properties {
+		values := r.Header.Values(name)
+		switch len(values) {
+		case 0:
+		case 1:
+			document[name] = values[0]
+		default:
+			items := make([]interface{}, len(values))
+			for i, value := range values {
+				items[i] = value
+			}
+			document[name] = items
+		}
+	}
+
+	return headers.ValidateGoValue(document)
+}
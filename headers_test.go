@@ -0,0 +1,91 @@
+package valid
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareHeaderSchema(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	headers, err := NewFromString(`{
+		"type": "object",
+		"properties": {
+			"x-api-version": {"enum": ["2024-01", "2025-01"]},
+			"X-Tag": {"type": "array", "maxItems": 2}
+		},
+		"required": ["x-api-version"]
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator de cabeçalhos: %v", err)
+	}
+
+	var called bool
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{HeaderSchema: headers}, func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	body := `{"name": "Ana", "email": "ana@x.com"}`
+	tests := []struct {
+		name    string
+		headers map[string][]string
+		valid   bool
+		field   string
+	}{
+		{"versão válida", map[string][]string{"X-API-VERSION": {"2025-01"}}, true, ""},
+		{"versão ausente", nil, false, "x-api-version"},
+		{"versão desconhecida", map[string][]string{"X-Api-Version": {"1999-01"}}, false, "x-api-version"},
+		{"cabeçalho repetido como array", map[string][]string{"X-Api-Version": {"2024-01"}, "X-Tag": {"a", "b"}}, true, ""},
+		{"cabeçalho repetido demais", map[string][]string{"X-Api-Version": {"2024-01"}, "X-Tag": {"a", "b", "c"}}, false, "X-Tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			req := httptest.NewRequest("POST", "/test", strings.NewReader(body))
+			for name, values := range tt.headers {
+				for _, value := range values {
+					req.Header.Add(name, value)
+				}
+			}
+			w := httptest.NewRecorder()
+			middleware(w, req)
+
+			if called != tt.valid {
+				t.Fatalf("esperava handler chamado=%v, status %d: %s", tt.valid, w.Code, w.Body.String())
+			}
+			if tt.valid {
+				return
+			}
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("esperava status 400, recebeu %d", w.Code)
+			}
+			var response ErrorResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("erro ao decodificar resposta: %v", err)
+			}
+			if len(response.Details) == 0 {
+				t.Fatal("esperava detalhes do erro")
+			}
+			if detail := response.Details[0]; detail.Field != tt.field && detail.Details["property"] != tt.field {
+				t.Errorf("esperava erro sobre o cabeçalho '%s', recebeu %+v", tt.field, response.Details)
+			}
+		})
+	}
+
+	// Valid headers do not hide an invalid body
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "A"}`))
+	req.Header.Set("X-Api-Version", "2024-01")
+	called = false
+	w := httptest.NewRecorder()
+	middleware(w, req)
+	if called || w.Code != http.StatusBadRequest {
+		t.Errorf("esperava corpo inválido rejeitado, recebeu status %d", w.Code)
+	}
+}
@@ -0,0 +1,70 @@
+package valid
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// NewFromJSONC creates a validator from a JSON Schema with comments (JSONC).
+// Line comments (//) and block comments (/* */) are removed before parsing,
+// except inside string literals, so a pattern such as "^https?://" is kept.
+// Each comment is replaced by whitespace, keeping the line of syntax errors.
+func NewFromJSONC(schemaBytes []byte) (*Validator, error) {
+	stripped, err := stripJSONComments(schemaBytes)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromBytes(stripped)
+}
+
+// stripJSONComments returns a copy of data with its comments blanked out,
+// keeping the newlines they contain
+func stripJSONComments(data []byte) ([]byte, error) {
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		switch {
+		case c == '"':
+			// Copies the string literal as is, honoring escaped quotes
+			end := i + 1
+			for end < len(data) && data[end] != '"' {
+				if data[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(data) {
+				end = len(data) - 1
+			}
+			out.Write(data[i : end+1])
+			i = end
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				out.WriteByte(' ')
+				i++
+			}
+			if i < len(data) {
+				out.WriteByte('\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			end := bytes.Index(data[i+2:], []byte("*/"))
+			if end < 0 {
+				return nil, fmt.Errorf("schema JSONC inválido: comentário de bloco não fechado")
+			}
+			for _, b := range data[i : i+2+end+2] {
+				if b == '\n' {
+					out.WriteByte('\n')
+				} else {
+					out.WriteByte(' ')
+				}
+			}
+			i += 2 + end + 1
+		default:
+			out.WriteByte(c)
+		}
+	}
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewFromJSONC(t *testing.T) {
+	validator, err := NewFromJSONC([]byte(`// Schema de usuário
+{
+	/* propriedades
+	   do usuário */
+	"type": "object",
+	"properties": {
+		"site": {"type": "string", "pattern": "^https?://"}, // URL do site
+		"note": {"const": "/* não é comentário */ // nem isto"},
+		"quote": {"const": "aspas \" // escapadas"}
+	},
+	"required": ["site"] /* obrigatórios */
+}
+`))
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"site": "https://example.com", "note": "/* não é comentário */ // nem isto", "quote": "aspas \" // escapadas"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava dados válidos, recebeu %+v", result.Errors)
+	}
+
+	result, err = validator.ValidateString(`{"site": "ftp://example.com"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Error("esperava dados inválidos para o padrão do site")
+	}
+}
+
+func TestNewFromJSONCErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		message string
+	}{
+		{"comentário não fechado", `{"type": "object"} /* sem fim`, "comentário de bloco não fechado"},
+		{"JSON inválido", "{\n// comentário\n\"type\": }", "schema JSON inválido"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFromJSONC([]byte(tt.schema))
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("esperava erro contendo '%s', recebeu %v", tt.message, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// KeywordFunc validates a value against a custom schema keyword. It receives
+// the value, the keyword's parameter as written in the schema and the dotted
+// path of the value, and returns the violations found. Field, Constraint and
+// Code are filled in from the path and the keyword name when left empty.
+type KeywordFunc func(value interface{}, schemaParam interface{}, path string) []ValidationError
+
+var (
+	keywordsMu         sync.RWMutex
+	registeredKeywords = map[string]KeywordFunc{
+		"x-unique-by": uniqueBy,
+	}
+)
+
+// RegisterKeyword registers a custom schema keyword, checked by every validator
+// in a pass after the standard validation. It returns an error when the name is
+// already taken instead of silently replacing it.
+func RegisterKeyword(name string, fn KeywordFunc) error {
+	if name == "" || fn == nil {
+		return fmt.Errorf("nome e função da palavra-chave são obrigatórios")
+	}
+
+	keywordsMu.Lock()
+	defer keywordsMu.Unlock()
+
+	if _, exists := registeredKeywords[name]; exists {
+		return fmt.Errorf("palavra-chave '%s' já está registrada", name)
+	}
+
+	registeredKeywords[name] = fn
+	return nil
+}
+
+// UnregisterKeyword removes a custom keyword, mostly for test cleanup
+func UnregisterKeyword(name string) {
+	keywordsMu.Lock()
+	defer keywordsMu.Unlock()
+
+	delete(registeredKeywords, name)
+}
+
+// schemaKeywordSet returns every keyword used by the schema or its subschemas
+func schemaKeywordSet(schema map[string]interface{}) map[string]bool {
+	keywords := make(map[string]bool)
+	walkSubschemas(schema, func(subschema map[string]interface{}) {
+		for keyword := range subschema {
+			keywords[keyword] = true
+		}
+	})
+	return keywords
+}
+
+// activeKeywords returns the registered custom keywords the schema uses
+func (v *Validator) activeKeywords() map[string]KeywordFunc {
+	keywordsMu.RLock()
+	defer keywordsMu.RUnlock()
+
+	var active map[string]KeywordFunc
+	for name, fn := range registeredKeywords {
+		if v.schemaKeywords[name] {
+			if active == nil {
+				active = make(map[string]KeywordFunc)
+			}
+			active[name] = fn
+		}
+	}
+	return active
+}
+
+// checkKeywords runs the custom keywords of the schemas that apply to each
+// value of the document
+func (v *Validator) checkKeywords(document interface{}, keywords map[string]KeywordFunc) []ValidationError {
+	var errs []ValidationError
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		for _, schema := range schemas {
+			for name, fn := range keywords {
+				param, ok := schema[name]
+				if !ok {
+					continue
+				}
+
+				for _, keywordErr := range fn(value, param, path) {
+					if keywordErr.Constraint == "" {
+						keywordErr.Constraint = name
+					}
+					errs = append(errs, v.completeError(keywordErr, path))
+				}
+			}
+		}
+	})
+
+	sortErrorsByField(errs)
+
+	return errs
+}
+
+// sortErrorsByField orders errors found walking the document, which is walked in
+// map order, by field and constraint
+func sortErrorsByField(errs []ValidationError) {
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].Field != errs[j].Field {
+			return errs[i].Field < errs[j].Field
+		}
+		return errs[i].Constraint < errs[j].Constraint
+	})
+}
+
+// completeError fills in the field, custom message and code of a violation
+// produced outside gojsonschema. The field defaults to path.
+func (v *Validator) completeError(validationErr ValidationError, path string) ValidationError {
+	if validationErr.Field == "" {
+		validationErr.Field = path
+	}
+	fieldMessages := v.customErrors[v.schemaFieldKey(validationErr.Field)]
+	for _, key := range []string{validationErr.Constraint, constraintKeyword(validationErr.Constraint), "_"} {
+		if message, ok := fieldMessages[key]; ok {
+			validationErr.Message = message
+			validationErr.redactedMessage = ""
+			break
+		}
+	}
+	if validationErr.Code == "" {
+		validationErr.Code = v.errorCode(validationErr.Field, validationErr.Constraint)
+	}
+	return validationErr
+}
+
+// uniqueBy implements x-unique-by: the objects of an array must not repeat the
+// value of the given property. Items without the property are not compared.
+func uniqueBy(value interface{}, schemaParam interface{}, path string) []ValidationError {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	property, ok := schemaParam.(string)
+	if !ok {
+		return nil
+	}
+
+	var errs []ValidationError
+	seen := make(map[string]int)
+
+	for i, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, ok := object[property]
+		if !ok {
+			continue
+		}
+
+		encoded, err := json.Marshal(key)
+		if err != nil {
+			continue
+		}
+
+		if first, exists := seen[string(encoded)]; exists {
+			errs = append(errs, ValidationError{
+				Field: path,
+				Message: fmt.Sprintf("os itens devem ser únicos por '%s': valor %s repetido nos índices %d e %d",
+					property, encoded, first, i),
+				Value: key,
+				redactedMessage: fmt.Sprintf("os itens devem ser únicos por '%s': valor repetido nos índices %d e %d",
+					property, first, i),
+			})
+			continue
+		}
+		seen[string(encoded)] = i
+	}
+
+	return errs
+}
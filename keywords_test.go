@@ -0,0 +1,126 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+const uniqueBySchema = `{
+	"type": "object",
+	"properties": {
+		"items": {
+			"type": "array",
+			"items": {"type": "object", "properties": {"sku": {"type": "string"}}},
+			"x-unique-by": "sku"
+		},
+		"tags": {
+			"type": "array",
+			"x-unique-by": "name",
+			"errorMessage": {"x-unique-by": "tags repetidas"}
+		}
+	}
+}`
+
+func TestUniqueBy(t *testing.T) {
+	validator, err := NewFromString(uniqueBySchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		jsonData      string
+		expectValid   bool
+		expectField   string
+		expectMessage string
+	}{
+		{name: "unique values", jsonData: `{"items": [{"sku": "a"}, {"sku": "b"}]}`, expectValid: true},
+		{name: "items without the property", jsonData: `{"items": [{}, {}, {"sku": "a"}]}`, expectValid: true},
+		{
+			name:          "repeated value",
+			jsonData:      `{"items": [{"sku": "a"}, {"sku": "b"}, {"sku": "a"}]}`,
+			expectField:   "items",
+			expectMessage: `valor "a" repetido nos índices 0 e 2`,
+		},
+		{
+			name:          "custom message",
+			jsonData:      `{"tags": [{"name": "x"}, {"name": "x"}]}`,
+			expectField:   "tags",
+			expectMessage: "tags repetidas",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Fatalf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+			if tt.expectValid {
+				return
+			}
+
+			if len(result.Errors) != 1 {
+				t.Fatalf("esperava 1 erro, recebeu %+v", result.Errors)
+			}
+			validationErr := result.Errors[0]
+			if validationErr.Field != tt.expectField || validationErr.Constraint != "x-unique-by" {
+				t.Errorf("esperava erro x-unique-by em '%s', recebeu %+v", tt.expectField, validationErr)
+			}
+			if !strings.Contains(validationErr.Message, tt.expectMessage) {
+				t.Errorf("esperava mensagem contendo '%s', recebeu '%s'", tt.expectMessage, validationErr.Message)
+			}
+			if validationErr.Code != "validation.x-unique-by" {
+				t.Errorf("código inesperado '%s'", validationErr.Code)
+			}
+		})
+	}
+}
+
+func TestRegisterKeyword(t *testing.T) {
+	err := RegisterKeyword("x-even-count", func(value, param interface{}, path string) []ValidationError {
+		items, ok := value.([]interface{})
+		if !ok || len(items)%2 == 0 {
+			return nil
+		}
+		return []ValidationError{{Message: "a lista deve ter um número par de itens", Value: len(items)}}
+	})
+	if err != nil {
+		t.Fatalf("erro ao registrar palavra-chave: %v", err)
+	}
+	defer UnregisterKeyword("x-even-count")
+
+	if err := RegisterKeyword("x-even-count", func(interface{}, interface{}, string) []ValidationError { return nil }); err == nil {
+		t.Error("esperava erro ao registrar palavra-chave repetida")
+	}
+	if err := RegisterKeyword("x-unique-by", func(interface{}, interface{}, string) []ValidationError { return nil }); err == nil {
+		t.Error("esperava erro ao substituir palavra-chave embutida")
+	}
+
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {"pairs": {"type": "array", "items": {"type": "integer"}, "x-even-count": true}}
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"pairs": [1, 2, 3]}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Field != "pairs" || result.Errors[0].Constraint != "x-even-count" {
+		t.Errorf("esperava erro x-even-count em 'pairs', recebeu %+v", result.Errors)
+	}
+
+	result, err = validator.ValidateString(`{"pairs": [1, 2, "x"]}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if len(result.Errors) != 2 {
+		t.Errorf("esperava o erro de tipo e o da palavra-chave, recebeu %+v", result.Errors)
+	}
+}
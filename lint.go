@@ -0,0 +1,118 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// LintWarning is a suspicious pattern found in a schema that is valid JSON
+// but probably does not behave as its author intended
+type LintWarning struct {
+	// Path is the JSON Pointer of the subschema, empty for the root
+	Path    string `json:"path"`
+	Keyword string `json:"keyword"`
+	Message string `json:"message"`
+}
+
+// lintBounds are the pairs of keywords whose lower bound must not exceed the upper bound
+var lintBounds = [][2]string{
+	{"minimum", "maximum"},
+	{"minLength", "maxLength"},
+	{"minItems", "maxItems"},
+	{"minProperties", "maxProperties"},
+}
+
+// LintSchema reports common mistakes in a schema: lower bounds greater than
+// their upper bounds, required properties not declared in properties, formats
+// with no registered checker and patterns that do not compile. Schemas that are
+// not valid JSON produce a single warning at the root. The warnings are sorted
+// by path and keyword, so the output is stable in CI.
+func LintSchema(schemaBytes []byte) []LintWarning {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		return []LintWarning{{Message: fmt.Sprintf("schema JSON inválido: %v", err)}}
+	}
+
+	var warnings []LintWarning
+	walkSubschemasAt(schema, "", func(pointer string, subschema map[string]interface{}) {
+		warnings = append(warnings, lintSubschema(pointer, subschema)...)
+	})
+
+	sort.SliceStable(warnings, func(i, j int) bool {
+		if warnings[i].Path != warnings[j].Path {
+			return warnings[i].Path < warnings[j].Path
+		}
+		return warnings[i].Keyword < warnings[j].Keyword
+	})
+	return warnings
+}
+
+// lintSubschema returns the warnings of a single subschema, without its children
+func lintSubschema(pointer string, schema map[string]interface{}) []LintWarning {
+	var warnings []LintWarning
+
+	for _, bound := range lintBounds {
+		lower, lowerOK := schema[bound[0]].(float64)
+		upper, upperOK := schema[bound[1]].(float64)
+		if lowerOK && upperOK && lower > upper {
+			warnings = append(warnings, LintWarning{
+				Path:    pointer,
+				Keyword: bound[0],
+				Message: fmt.Sprintf("%s (%v) é maior que %s (%v), nenhum valor é aceito", bound[0], lower, bound[1], upper),
+			})
+		}
+	}
+
+	// Properties matched by patternProperties may be required without being listed
+	properties, hasProperties := schema["properties"].(map[string]interface{})
+	if _, hasPatterns := schema["patternProperties"]; hasProperties && !hasPatterns {
+		required, _ := schema["required"].([]interface{})
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, declared := properties[name]; !declared {
+					warnings = append(warnings, LintWarning{
+						Path:    pointer,
+						Keyword: "required",
+						Message: fmt.Sprintf("a propriedade obrigatória '%s' não está declarada em properties", name),
+					})
+				}
+			}
+		}
+	}
+
+	if format, ok := schema["format"].(string); ok && !gojsonschema.FormatCheckers.Has(format) {
+		warnings = append(warnings, LintWarning{
+			Path:    pointer,
+			Keyword: "format",
+			Message: fmt.Sprintf("o formato '%s' não é reconhecido e será ignorado", format),
+		})
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			warnings = append(warnings, LintWarning{
+				Path:    pointer,
+				Keyword: "pattern",
+				Message: fmt.Sprintf("o pattern '%s' não compila: %v", pattern, err),
+			})
+		}
+	}
+
+	if patterns, ok := schema["patternProperties"].(map[string]interface{}); ok {
+		for pattern := range patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				warnings = append(warnings, LintWarning{
+					Path:    pointer,
+					Keyword: "patternProperties",
+					Message: fmt.Sprintf("o pattern '%s' não compila: %v", pattern, err),
+				})
+			}
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,51 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintSchema(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"required": ["name", "nmae"],
+		"properties": {
+			"name": {"type": "string", "minLength": 10, "maxLength": 5},
+			"age": {"type": "integer", "minimum": 18, "maximum": 10},
+			"code": {"type": "string", "pattern": "^[A-Z"},
+			"site": {"type": "string", "format": "url"},
+			"email": {"type": "string", "format": "email", "pattern": "^.+@.+$"},
+			"tags": {"type": "array", "minItems": 1, "maxItems": 3}
+		}
+	}`
+
+	warnings := LintSchema([]byte(schema))
+
+	expected := []struct{ path, keyword string }{
+		{"", "required"},
+		{"/properties/age", "minimum"},
+		{"/properties/code", "pattern"},
+		{"/properties/name", "minLength"},
+		{"/properties/site", "format"},
+	}
+	if len(warnings) != len(expected) {
+		t.Fatalf("esperava %d avisos, recebeu %+v", len(expected), warnings)
+	}
+	for i, want := range expected {
+		if warnings[i].Path != want.path || warnings[i].Keyword != want.keyword {
+			t.Errorf("aviso %d: esperava %s em '%s', recebeu %+v", i, want.keyword, want.path, warnings[i])
+		}
+	}
+	if !strings.Contains(warnings[0].Message, "'nmae'") {
+		t.Errorf("esperava a propriedade não declarada na mensagem, recebeu '%s'", warnings[0].Message)
+	}
+
+	if warnings := LintSchema([]byte(testSchema)); len(warnings) != 0 {
+		t.Errorf("não esperava avisos para o schema de teste, recebeu %+v", warnings)
+	}
+
+	warnings = LintSchema([]byte(`{"type": `))
+	if len(warnings) != 1 || warnings[0].Path != "" || !strings.HasPrefix(warnings[0].Message, "schema JSON inválido") {
+		t.Errorf("esperava um aviso de JSON inválido, recebeu %+v", warnings)
+	}
+}
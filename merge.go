@@ -0,0 +1,40 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewFromMerged creates a validator that requires a document to satisfy all
+// of the given schemas, combining them under a single allOf
+func NewFromMerged(schemas ...[]byte) (*Validator, error) {
+	if len(schemas) == 0 {
+		return nil, fmt.Errorf("pelo menos um schema deve ser informado")
+	}
+
+	parts := make([]json.RawMessage, 0, len(schemas))
+
+	for i, schemaBytes := range schemas {
+		if len(schemaBytes) == 0 {
+			return nil, fmt.Errorf("schema %d não pode estar vazio", i)
+		}
+
+		var schemaObj map[string]interface{}
+		if err := json.Unmarshal(schemaBytes, &schemaObj); err != nil {
+			return nil, fmt.Errorf("schema %d JSON inválido: %w", i, err)
+		}
+
+		parts = append(parts, json.RawMessage(schemaBytes))
+	}
+
+	mergedBytes, err := json.Marshal(map[string]interface{}{
+		"allOf": parts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao combinar schemas: %w", err)
+	}
+
+	// The per-field settings of the fragments (messages, codes, severities) are
+	// extracted from the allOf branches, also when the validator is reloaded
+	return NewFromBytes(mergedBytes)
+}
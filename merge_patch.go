@@ -0,0 +1,75 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ValidateMergePatch applies a JSON Merge Patch (RFC 7386) to the original
+// document and validates the merged document against the full schema, so a
+// PATCH can not move a resource into an invalid state. The merged document is
+// returned along with the result, with its keys sorted and numbers kept as
+// written.
+//
+// An explicit null in the patch deletes the key, so patching a required
+// property with null is reported as a missing required property. Since null
+// can not be set through a merge patch, a property whose schema accepts null
+// can only be cleared by removing it.
+func (v *Validator) ValidateMergePatch(original, patch []byte) (*ValidationResult, []byte, error) {
+	originalDoc, err := decodeMergeDocument(original)
+	if err != nil {
+		return nil, nil, fmt.Errorf("documento original JSON inválido: %w", err)
+	}
+	patchDoc, err := decodeMergeDocument(patch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("merge patch JSON inválido: %w", err)
+	}
+
+	merged, err := json.Marshal(mergePatch(originalDoc, patchDoc))
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao serializar documento mesclado: %w", err)
+	}
+
+	result, err := v.ValidateBytes(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, merged, nil
+}
+
+// decodeMergeDocument decodes a document of a merge patch, keeping numbers exact
+func decodeMergeDocument(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// mergePatch applies patch to target as defined by RFC 7386: objects are
+// merged recursively, null members are removed and any other patch replaces
+// the target
+func mergePatch(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
@@ -0,0 +1,63 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMergePatch(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	original := []byte(`{"name": "Ana", "email": "ana@x.com", "age": 30}`)
+
+	tests := []struct {
+		name   string
+		patch  string
+		valid  bool
+		merged string
+	}{
+		{"altera campo", `{"name": "Beatriz"}`, true, `{"age":30,"email":"ana@x.com","name":"Beatriz"}`},
+		{"remove campo opcional", `{"age": null}`, true, `{"email":"ana@x.com","name":"Ana"}`},
+		{"remove campo obrigatório", `{"email": null}`, false, `{"age":30,"name":"Ana"}`},
+		{"valor inválido", `{"age": -1}`, false, `{"age":-1,"email":"ana@x.com","name":"Ana"}`},
+		{"patch substitui documento", `[]`, false, `[]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, merged, err := validator.ValidateMergePatch(original, []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.valid {
+				t.Errorf("esperava Valid=%v, recebeu %v: %+v", tt.valid, result.Valid, result.Errors)
+			}
+			if string(merged) != tt.merged {
+				t.Errorf("esperava documento mesclado %s, recebeu %s", tt.merged, merged)
+			}
+		})
+	}
+
+	// Nested objects are merged, keeping their other members
+	nested, err := NewFromString(`{"type": "object", "properties": {"address": {"type": "object", "required": ["city"]}}}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, merged, err := nested.ValidateMergePatch([]byte(`{"address": {"city": "Recife", "zip": "50000"}}`), []byte(`{"address": {"zip": null}}`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid || string(merged) != `{"address":{"city":"Recife"}}` {
+		t.Errorf("esperava merge aninhado válido, recebeu %s: %+v", merged, result.Errors)
+	}
+
+	if _, _, err := validator.ValidateMergePatch(original, []byte(`{"name":`)); err == nil || !strings.Contains(err.Error(), "merge patch JSON inválido") {
+		t.Errorf("esperava erro de patch inválido, recebeu %v", err)
+	}
+	if _, _, err := validator.ValidateMergePatch([]byte(`{`), []byte(`{}`)); err == nil || !strings.Contains(err.Error(), "documento original JSON inválido") {
+		t.Errorf("esperava erro de documento inválido, recebeu %v", err)
+	}
+}
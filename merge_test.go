@@ -0,0 +1,111 @@
+package valid
+
+import "testing"
+
+func TestNewFromMerged(t *testing.T) {
+	base := []byte(`{
+		"type": "object",
+		"properties": {"name": {"type": "string", "minLength": 2}},
+		"required": ["name"]
+	}`)
+	extension := []byte(`{
+		"type": "object",
+		"properties": {"email": {"type": "string", "format": "email"}},
+		"required": ["email"]
+	}`)
+
+	validator, err := NewFromMerged(base, extension)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		jsonData    string
+		expectValid bool
+	}{
+		{name: "satisfies all schemas", jsonData: `{"name": "Ana", "email": "ana@test.com"}`, expectValid: true},
+		{name: "fails base schema", jsonData: `{"email": "ana@test.com"}`, expectValid: false},
+		{name: "fails extension schema", jsonData: `{"name": "Ana"}`, expectValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Errorf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+		})
+	}
+
+	// Construction must fail when any input is not valid JSON
+	if _, err := NewFromMerged(base, []byte(`{"type": "object"`)); err == nil {
+		t.Error("esperava erro para schema com JSON inválido")
+	}
+
+	if _, err := NewFromMerged(); err == nil {
+		t.Error("esperava erro sem schemas")
+	}
+}
+
+func TestNewFromMergedFragmentSettings(t *testing.T) {
+	base := []byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "errorMessage": {"minLength": "nome muito curto"}, "errorCode": "NAME_SHORT"}
+		}
+	}`)
+	extension := []byte(`{
+		"type": "object",
+		"properties": {
+			"nickname": {"type": "string", "maxLength": 5, "x-severity": "warning"},
+			"card": {"type": "string"},
+			"cvv": {"type": "string"}
+		},
+		"dependencies": {"card": ["cvv"]},
+		"errorMessage": {"dependencies": "informe o cvv do cartão"}
+	}`)
+
+	validator, err := NewFromMerged(base, extension)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	check := func(stage string) {
+		result, err := validator.ValidateString(`{"name": "A", "nickname": "apelido longo", "card": "4111"}`)
+		if err != nil {
+			t.Fatalf("%s: não esperava erro, mas recebeu: %v", stage, err)
+		}
+
+		byField := make(map[string]ValidationError)
+		for _, validationErr := range result.Errors {
+			byField[validationErr.Field] = validationErr
+		}
+
+		if name := byField["name"]; name.Message != "nome muito curto" || name.Code != "NAME_SHORT" {
+			t.Errorf("%s: esperava mensagem e código do fragmento em name, recebeu %+v", stage, name)
+		}
+		if nickname := byField["nickname"]; nickname.Severity != SeverityWarning {
+			t.Errorf("%s: esperava aviso em nickname, recebeu %+v", stage, nickname)
+		}
+		found := false
+		for _, validationErr := range result.Errors {
+			if validationErr.Message == "informe o cvv do cartão" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: esperava a mensagem de dependência do fragmento, recebeu %+v", stage, result.Errors)
+		}
+	}
+
+	check("NewFromMerged")
+
+	if err := validator.Reload(validator.active().schemaBytes); err != nil {
+		t.Fatalf("erro ao recarregar schema: %v", err)
+	}
+	check("Reload")
+}
@@ -0,0 +1,199 @@
+package valid
+
+import (
+	"net/http"
+	"time"
+)
+
+// MiddlewareOption sets one MiddlewareConfig field for MiddlewareFunc, so new
+// settings can be added without breaking existing call sites
+type MiddlewareOption func(*MiddlewareConfig)
+
+// MiddlewareFunc returns a middleware built from functional options, the
+// equivalent of MiddlewareWithConfig with a config holding the given settings:
+//
+//	mw := validator.MiddlewareFunc(
+//		valid.WithSkipMethods("GET", "HEAD"),
+//		valid.WithMaxBodyBytes(1 << 20),
+//	)
+//	http.HandleFunc("/users", mw(userHandler))
+func (v *Validator) MiddlewareFunc(opts ...MiddlewareOption) func(next http.HandlerFunc) http.HandlerFunc {
+	var config MiddlewareConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return v.MiddlewareWithConfig(config, next)
+	}
+}
+
+// WithSkipMethods sets the HTTP methods that skip validation
+func WithSkipMethods(methods ...string) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.SkipMethods = methods
+	}
+}
+
+// WithErrorHandler sets the function that handles validation errors
+func WithErrorHandler(fn func(w http.ResponseWriter, r *http.Request, result *ValidationResult)) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.ErrorHandler = fn
+	}
+}
+
+// WithPartialMethods sets the HTTP methods validated with partial semantics,
+// also ignoring required in nested objects when stripNested is true
+func WithPartialMethods(stripNested bool, methods ...string) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.PartialMethods = methods
+		c.PartialStripNested = stripNested
+	}
+}
+
+// WithAllowEmptyBody sets the HTTP methods for which a zero-length body skips validation
+func WithAllowEmptyBody(methods ...string) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.AllowEmptyBody = methods
+	}
+}
+
+// WithErrorStatusCode sets the HTTP status used by the default error handler
+func WithErrorStatusCode(status int) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.ErrorStatusCode = status
+	}
+}
+
+// WithDirection enforces readOnly or writeOnly properties
+func WithDirection(direction Direction) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.Direction = direction
+	}
+}
+
+// WithRedactValues omits the offending values from the errors passed to the ErrorHandler
+func WithRedactValues() MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.RedactValues = true
+	}
+}
+
+// WithSkipFunc skips validation when fn returns true, in addition to the skipped methods
+func WithSkipFunc(fn func(r *http.Request) bool) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.SkipFunc = fn
+	}
+}
+
+// WithWarningHandler sets the function that receives the warnings of valid requests
+func WithWarningHandler(fn func(r *http.Request, warnings []ValidationError)) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.WarningHandler = fn
+	}
+}
+
+// WithDeprecationHandler sets the function that receives the deprecated fields of valid requests
+func WithDeprecationHandler(fn func(w http.ResponseWriter, r *http.Request, deprecations []ValidationError)) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.DeprecationHandler = fn
+	}
+}
+
+// WithMaxBodyBytes rejects bodies larger than n bytes with 413
+func WithMaxBodyBytes(n int64) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.MaxBodyBytes = n
+	}
+}
+
+// WithBodyReadHook sets the function that receives the number of bytes read from each request
+func WithBodyReadHook(fn func(n int64)) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.BodyReadHook = fn
+	}
+}
+
+// WithSummaryOnly makes the default error handler respond with a single summary message
+func WithSummaryOnly() MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.SummaryOnly = true
+	}
+}
+
+// WithErrorLogHook sets the function that receives the result of every rejected request
+func WithErrorLogHook(fn func(r *http.Request, result *ValidationResult)) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.ErrorLogHook = fn
+	}
+}
+
+// WithMultipartJSONField validates, in multipart/form-data requests, the JSON held by this field
+func WithMultipartJSONField(field string) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.MultipartJSONField = field
+	}
+}
+
+// WithDocsBaseURL links each error passed to the ErrorHandler to its documentation
+func WithDocsBaseURL(baseURL string) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.DocsBaseURL = baseURL
+	}
+}
+
+// WithSampling validates only the given fraction of the requests, reporting
+// each sampling decision to hook when it is not nil
+func WithSampling(rate float64, hook func(r *http.Request, sampled bool)) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.SampleRate = rate
+		c.SampleHook = hook
+	}
+}
+
+// WithTimeout responds 503 when reading and validating the body takes longer than d
+func WithTimeout(d time.Duration) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.Timeout = d
+	}
+}
+
+// WithRequestID makes the default error handler include the correlation ID
+// read from header, or from X-Request-ID when header is empty
+func WithRequestID(header string) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.IncludeRequestID = true
+		c.RequestIDHeader = header
+	}
+}
+
+// WithGraphQLVariables validates only the variables of GraphQL request bodies,
+// read from field, or from "variables" when field is empty
+func WithGraphQLVariables(field string) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.GraphQLVariables = true
+		c.GraphQLVariablesField = field
+	}
+}
+
+// WithMeta makes the default error handler include the validation time and schema version
+func WithMeta() MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.IncludeMeta = true
+	}
+}
+
+// WithHeaderSchema validates the request headers named in the properties of headers
+func WithHeaderSchema(headers *Validator) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.HeaderSchema = headers
+	}
+}
+
+// WithUnknownVersionHandler sets the response of MiddlewareByVersion to requests
+// with an unregistered version
+func WithUnknownVersionHandler(handler http.HandlerFunc) MiddlewareOption {
+	return func(c *MiddlewareConfig) {
+		c.UnknownVersionHandler = handler
+	}
+}
@@ -0,0 +1,62 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareFunc(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	var logged int
+	middleware := validator.MiddlewareFunc(
+		WithSkipMethods("GET"),
+		WithErrorStatusCode(http.StatusUnprocessableEntity),
+		WithMaxBodyBytes(64),
+		WithErrorLogHook(func(r *http.Request, result *ValidationResult) {
+			logged++
+		}),
+	)
+
+	var called bool
+	handler := middleware(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		status int
+		called bool
+	}{
+		{"método ignorado", "GET", `{}`, http.StatusOK, true},
+		{"dados válidos", "POST", `{"name": "Ana", "email": "ana@x.com"}`, http.StatusOK, true},
+		{"dados inválidos", "DELETE", `{"name": "A"}`, http.StatusUnprocessableEntity, false},
+		{"corpo grande demais", "POST", `{"name": "` + strings.Repeat("a", 100) + `"}`, http.StatusRequestEntityTooLarge, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			w := httptest.NewRecorder()
+			handler(w, httptest.NewRequest(tt.method, "/test", strings.NewReader(tt.body)))
+
+			if w.Code != tt.status {
+				t.Errorf("esperava status %d, recebeu %d", tt.status, w.Code)
+			}
+			if called != tt.called {
+				t.Errorf("esperava handler chamado=%v, recebeu %v", tt.called, called)
+			}
+		})
+	}
+
+	if logged != 1 {
+		t.Errorf("esperava 1 requisição registrada pelo hook, recebeu %d", logged)
+	}
+}
@@ -0,0 +1,64 @@
+package valid
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// multipartMaxMemory is the memory used to parse multipart forms before
+// spilling file parts to disk, the same default as net/http
+const multipartMaxMemory = 32 << 20
+
+// isMultipartRequest reports whether the request carries multipart/form-data
+func isMultipartRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// readMultipartField parses the multipart form and returns the content of the
+// named form field or file part. The parsed form stays available to the next
+// handler through r.MultipartForm.
+func readMultipartField(r *http.Request, field string) ([]byte, bool, error) {
+	if err := r.ParseMultipartForm(multipartMaxMemory); err != nil {
+		return nil, false, fmt.Errorf("erro ao ler formulário multipart: %w", err)
+	}
+
+	if values := r.MultipartForm.Value[field]; len(values) > 0 {
+		return []byte(values[0]), true, nil
+	}
+
+	files := r.MultipartForm.File[field]
+	if len(files) == 0 {
+		return nil, false, nil
+	}
+
+	file, err := files[0].Open()
+	if err != nil {
+		return nil, false, fmt.Errorf("erro ao abrir parte multipart '%s': %w", field, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, false, fmt.Errorf("erro ao ler parte multipart '%s': %w", field, err)
+	}
+	return data, true, nil
+}
+
+// missingMultipartField reports a multipart request without the JSON field
+func missingMultipartField(field string) *ValidationResult {
+	return &ValidationResult{
+		Valid: false,
+		Errors: []ValidationError{
+			{
+				Field:      field,
+				Message:    fmt.Sprintf("campo multipart '%s' é obrigatório", field),
+				Constraint: "required",
+				Code:       codePrefix + "required",
+				Severity:   SeverityError,
+			},
+		},
+	}
+}
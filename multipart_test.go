@@ -0,0 +1,89 @@
+package valid
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newMultipartRequest builds a multipart request with a metadata field, sent as
+// a file part when asFile is true, and an uploaded file
+func newMultipartRequest(t *testing.T, metadata string, asFile bool) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	if metadata != "" {
+		if asFile {
+			part, err := writer.CreateFormFile("metadata", "metadata.json")
+			if err != nil {
+				t.Fatalf("erro ao criar parte: %v", err)
+			}
+			part.Write([]byte(metadata))
+		} else {
+			writer.WriteField("metadata", metadata)
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", "photo.jpg")
+	if err != nil {
+		t.Fatalf("erro ao criar parte: %v", err)
+	}
+	part.Write([]byte("binary content"))
+	writer.Close()
+
+	req := httptest.NewRequest("POST", "/upload", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestMiddlewareMultipartJSONField(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	var uploaded string
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		MultipartJSONField: "metadata",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("file")
+		if err == nil {
+			content := make([]byte, 64)
+			n, _ := file.Read(content)
+			uploaded = string(content[:n])
+			file.Close()
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		req      *http.Request
+		expected int
+	}{
+		{"campo válido", newMultipartRequest(t, `{"name": "Ana", "email": "ana@x.com"}`, false), http.StatusOK},
+		{"parte de arquivo válida", newMultipartRequest(t, `{"name": "Ana", "email": "ana@x.com"}`, true), http.StatusOK},
+		{"campo inválido", newMultipartRequest(t, `{"name": "A"}`, false), http.StatusBadRequest},
+		{"campo ausente", newMultipartRequest(t, "", false), http.StatusBadRequest},
+		{"requisição JSON", httptest.NewRequest("POST", "/upload", strings.NewReader(`{"name": "Ana", "email": "ana@x.com"}`)), http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			middleware(w, tt.req)
+			if w.Code != tt.expected {
+				t.Errorf("esperava status %d, recebeu %d: %s", tt.expected, w.Code, w.Body.String())
+			}
+		})
+	}
+
+	if uploaded != "binary content" {
+		t.Errorf("o handler deveria acessar o arquivo enviado, recebeu '%s'", uploaded)
+	}
+}
@@ -0,0 +1,58 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// nonFiniteConstraint is the constraint of the errors produced for numbers
+// that do not fit a float64
+const nonFiniteConstraint = "number"
+
+// findNonFinite reports the numbers of the document that overflow a float64,
+// such as 1e400, which would become ±Inf once decoded by most consumers.
+// NaN and Infinity are not JSON and are already rejected as malformed.
+func (v *Validator) findNonFinite(document interface{}) []ValidationError {
+	var errs []ValidationError
+	walkNumbers(document, "", func(path string, number json.Number) {
+		f, err := strconv.ParseFloat(string(number), 64)
+		if err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+			return
+		}
+
+		errs = append(errs, ValidationError{
+			Field:           path,
+			Message:         fmt.Sprintf("número fora do intervalo representável: %s", number),
+			redactedMessage: "número fora do intervalo representável",
+			Value:           number,
+			Constraint:      nonFiniteConstraint,
+			Code:            v.errorCode(path, nonFiniteConstraint),
+		})
+	})
+
+	// Objects are walked in map order
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Field < errs[j].Field
+	})
+
+	return errs
+}
+
+// walkNumbers calls fn for every number of a document decoded with UseNumber
+func walkNumbers(value interface{}, path string, fn func(path string, number json.Number)) {
+	switch typed := value.(type) {
+	case json.Number:
+		fn(path, typed)
+	case map[string]interface{}:
+		for key, child := range typed {
+			walkNumbers(child, joinFieldPath(path, key), fn)
+		}
+	case []interface{}:
+		for i, child := range typed {
+			walkNumbers(child, joinFieldPath(path, strconv.Itoa(i)), fn)
+		}
+	}
+}
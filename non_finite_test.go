@@ -0,0 +1,81 @@
+package valid
+
+import "testing"
+
+func TestRejectNonFinite(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"amount": {"type": "number"},
+			"values": {"type": "array", "items": {"type": "number"}}
+		}
+	}`)
+
+	lenient, err := NewFromBytes(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	strict, err := NewFromBytesWithOptions(schema, Options{RejectNonFinite: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		jsonData     string
+		expectLoose  bool
+		expectFields []string
+	}{
+		{name: "finite numbers", jsonData: `{"amount": 1.5e300, "values": [0, -1.7976931348623157e308]}`, expectLoose: true},
+		{name: "underflow rounds to zero", jsonData: `{"amount": 1e-400}`, expectLoose: true},
+		{name: "overflow", jsonData: `{"amount": 1e400}`, expectLoose: true, expectFields: []string{"amount"}},
+		{name: "negative overflow in array", jsonData: `{"values": [1, -2e308]}`, expectLoose: true, expectFields: []string{"values.1"}},
+		{name: "several overflows", jsonData: `{"amount": 9e999, "values": [1e400]}`, expectLoose: true, expectFields: []string{"amount", "values.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := lenient.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectLoose {
+				t.Errorf("sem RejectNonFinite esperava valid=%v, recebeu %+v", tt.expectLoose, result.Errors)
+			}
+
+			result, err = strict.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != (len(tt.expectFields) == 0) {
+				t.Fatalf("com RejectNonFinite esperava valid=%v, recebeu %+v", len(tt.expectFields) == 0, result.Errors)
+			}
+			if len(result.Errors) != len(tt.expectFields) {
+				t.Fatalf("esperava erros em %v, recebeu %+v", tt.expectFields, result.Errors)
+			}
+			for i, field := range tt.expectFields {
+				if result.Errors[i].Field != field || result.Errors[i].Constraint != "number" {
+					t.Errorf("esperava erro 'number' em '%s', recebeu %+v", field, result.Errors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRejectNonFiniteLiterals(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(`{"type": "object"}`), Options{RejectNonFinite: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	// NaN and Infinity are not JSON, the document is rejected as malformed
+	for _, jsonData := range []string{`{"amount": NaN}`, `{"amount": Infinity}`, `{"amount": -Infinity}`} {
+		result, err := validator.ValidateString(jsonData)
+		if err != nil {
+			t.Fatalf("não esperava erro, mas recebeu: %v", err)
+		}
+		if result.Valid || len(result.Errors) != 1 || result.Errors[0].Code != codeInvalidJSON {
+			t.Errorf("esperava JSON inválido para %s, recebeu %+v", jsonData, result.Errors)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package valid
+
+import "strings"
+
+// Schema extensions that normalize string values before validation
+const (
+	trimKeyword      = "x-trim"
+	lowercaseKeyword = "x-lowercase"
+	uppercaseKeyword = "x-uppercase"
+	// enumFoldKeyword matches strings against the enum of the schema ignoring
+	// case, replacing them with the enum value as written
+	enumFoldKeyword = "x-enum-case-insensitive"
+)
+
+// normalizeString applies the normalization extensions of the schemas to a
+// string, reporting whether it changed
+func normalizeString(schemas []map[string]interface{}, str string) (string, bool) {
+	normalized := str
+	for _, schema := range schemas {
+		if enabled, _ := schema[trimKeyword].(bool); enabled {
+			normalized = strings.TrimSpace(normalized)
+		}
+		if enabled, _ := schema[lowercaseKeyword].(bool); enabled {
+			normalized = strings.ToLower(normalized)
+		}
+		if enabled, _ := schema[uppercaseKeyword].(bool); enabled {
+			normalized = strings.ToUpper(normalized)
+		}
+		if enabled, _ := schema[enumFoldKeyword].(bool); enabled {
+			normalized = canonicalEnumValue(schema, normalized)
+		}
+	}
+	return normalized, normalized != str
+}
+
+// canonicalEnumValue returns the string of the schema enum equal to str
+// ignoring case, preferring an exact match, or str when there is none
+func canonicalEnumValue(schema map[string]interface{}, str string) string {
+	enum, _ := schema["enum"].([]interface{})
+
+	canonical := str
+	found := false
+	for _, value := range enum {
+		candidate, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if candidate == str {
+			return str
+		}
+		if !found && strings.EqualFold(candidate, str) {
+			canonical, found = candidate, true
+		}
+	}
+	return canonical
+}
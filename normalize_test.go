@@ -0,0 +1,103 @@
+package valid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const normalizeSchema = `{
+	"type": "object",
+	"properties": {
+		"email": {"type": "string", "format": "email", "maxLength": 16, "x-trim": true, "x-lowercase": true},
+		"state": {"type": "string", "pattern": "^[A-Z]{2}$", "x-trim": true, "x-uppercase": true},
+		"note": {"type": "string"},
+		"tags": {"type": "array", "items": {"type": "string", "x-lowercase": true}}
+	}
+}`
+
+func TestNormalize(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(normalizeSchema), Options{Normalize: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"email": " Joao@Example.com ", "state": " sp", "note": " livre ", "tags": ["A", "b"]}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Fatalf("esperava documento válido após normalização, recebeu %+v", result.Errors)
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(result.Normalized, &normalized); err != nil {
+		t.Fatalf("erro ao decodificar documento normalizado: %v", err)
+	}
+	if normalized["email"] != "joao@example.com" || normalized["state"] != "SP" {
+		t.Errorf("valores normalizados inesperados: %+v", normalized)
+	}
+	if normalized["note"] != " livre " {
+		t.Errorf("campos sem extensão não deveriam mudar, recebeu '%v'", normalized["note"])
+	}
+	if tags := normalized["tags"].([]interface{}); tags[0] != "a" {
+		t.Errorf("esperava itens normalizados, recebeu %v", tags)
+	}
+
+	// Without the option values are validated as sent
+	plain, _ := NewFromString(normalizeSchema)
+	result, _ = plain.ValidateString(`{"email": " Joao@Example.com "}`)
+	if result.Valid || result.Normalized != nil {
+		t.Errorf("esperava erro sem Normalize, recebeu %+v", result)
+	}
+}
+
+func TestNormalizeEnumCaseInsensitive(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"role": {"enum": ["customer", "supplier"], "x-enum-case-insensitive": true},
+			"code": {"enum": ["a", "A", 1], "x-enum-case-insensitive": true},
+			"kind": {"enum": ["retail"]}
+		}
+	}`)
+
+	normalizing, err := NewFromBytesWithOptions(schema, Options{Normalize: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	plain, err := NewFromBytes(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := normalizing.ValidateString(`{"role": "Customer", "code": "A"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Fatalf("esperava documento válido ignorando maiúsculas, recebeu %+v", result.Errors)
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(result.Normalized, &normalized); err != nil {
+		t.Fatalf("erro ao decodificar documento normalizado: %v", err)
+	}
+	if normalized["role"] != "customer" {
+		t.Errorf("esperava o valor canônico 'customer', recebeu %v", normalized["role"])
+	}
+	if normalized["code"] != "A" {
+		t.Errorf("esperava manter o valor idêntico do enum, recebeu %v", normalized["code"])
+	}
+
+	// Without the option the casing must match
+	result, _ = plain.ValidateString(`{"role": "Customer"}`)
+	if result.Valid {
+		t.Error("esperava erro de enum sem Normalize")
+	}
+
+	// Enums without the extension stay case-sensitive
+	result, _ = normalizing.ValidateString(`{"kind": "Retail"}`)
+	if result.Valid {
+		t.Error("esperava erro de enum sem a extensão")
+	}
+}
@@ -0,0 +1,183 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// openAPISchemaPrefix is the location of component schemas within an OpenAPI 3 spec
+const openAPISchemaPrefix = "#/components/schemas/"
+
+// NewFromOpenAPI creates a validator from the schema named componentName in the
+// components/schemas section of an OpenAPI 3 spec given as JSON.
+//
+// The component becomes a Draft 7 schema holding every component schema under
+// definitions, so $refs to #/components/schemas/... resolve within the spec.
+// OpenAPI-specific keywords are converted:
+//
+//   - nullable: true adds "null" to the type (or to enum), or wraps a $ref in anyOf
+//   - example becomes examples
+//   - discriminator makes its propertyName required, oneOf/anyOf still selecting the branch
+//   - deprecated becomes x-deprecated, reported with the WarnDeprecated option
+//   - boolean exclusiveMinimum/exclusiveMaximum become the Draft 7 numeric form
+//
+// Other local $refs, such as #/components/parameters/..., are not supported.
+func NewFromOpenAPI(specBytes []byte, componentName string) (*Validator, error) {
+	var spec struct {
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(specBytes, &spec); err != nil {
+		return nil, fmt.Errorf("especificação OpenAPI inválida: %w", err)
+	}
+
+	component, ok := spec.Components.Schemas[componentName]
+	if !ok {
+		return nil, fmt.Errorf("componente '%s' não encontrado em components/schemas", componentName)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(component, &schema); err != nil {
+		return nil, fmt.Errorf("componente '%s' inválido: %w", componentName, err)
+	}
+
+	definitions, _ := schema["definitions"].(map[string]interface{})
+	if definitions == nil {
+		definitions = make(map[string]interface{})
+	}
+	for name, raw := range spec.Components.Schemas {
+		var definition interface{}
+		if err := json.Unmarshal(raw, &definition); err != nil {
+			return nil, fmt.Errorf("componente '%s' inválido: %w", name, err)
+		}
+		definitions[name] = definition
+	}
+	schema["definitions"] = definitions
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+
+	if err := convertOpenAPISchema(schema); err != nil {
+		return nil, err
+	}
+
+	schemaBytes, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao serializar schema convertido: %w", err)
+	}
+	return NewFromBytes(schemaBytes)
+}
+
+// convertOpenAPISchema rewrites, in place, the OpenAPI keywords of schema and
+// of its subschemas to their Draft 7 equivalents
+func convertOpenAPISchema(schema map[string]interface{}) error {
+	var refErr error
+	walkSubschemas(schema, func(subschema map[string]interface{}) {
+		if err := convertOpenAPIRef(subschema); err != nil && refErr == nil {
+			refErr = err
+		}
+		convertNullable(subschema)
+		convertOpenAPIAnnotations(subschema)
+		convertExclusiveBound(subschema, "exclusiveMinimum", "minimum")
+		convertExclusiveBound(subschema, "exclusiveMaximum", "maximum")
+	})
+	return refErr
+}
+
+// convertOpenAPIRef points $refs to component schemas at their definitions entry
+func convertOpenAPIRef(schema map[string]interface{}) error {
+	ref, ok := schema["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#") || strings.HasPrefix(ref, "#/definitions/") {
+		return nil
+	}
+
+	if !strings.HasPrefix(ref, openAPISchemaPrefix) {
+		return fmt.Errorf("referência '%s' não suportada: apenas #/components/schemas/ é resolvida", ref)
+	}
+	schema["$ref"] = "#/definitions/" + strings.TrimPrefix(ref, openAPISchemaPrefix)
+	return nil
+}
+
+// convertNullable turns nullable: true into a schema that also accepts null
+func convertNullable(schema map[string]interface{}) {
+	nullable, _ := schema["nullable"].(bool)
+	delete(schema, "nullable")
+	if !nullable {
+		return
+	}
+
+	// Keywords next to $ref are ignored by Draft 7, so null becomes an alternative
+	if ref, ok := schema["$ref"]; ok {
+		delete(schema, "$ref")
+		schema["anyOf"] = []interface{}{
+			map[string]interface{}{"$ref": ref},
+			map[string]interface{}{"type": "null"},
+		}
+		return
+	}
+
+	switch typed := schema["type"].(type) {
+	case string:
+		schema["type"] = []interface{}{typed, "null"}
+	case []interface{}:
+		if !containsValue(typed, "null") {
+			schema["type"] = append(typed, "null")
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, nil) {
+		schema["enum"] = append(enum, nil)
+	}
+}
+
+// convertOpenAPIAnnotations converts example, discriminator and deprecated
+func convertOpenAPIAnnotations(schema map[string]interface{}) {
+	if example, ok := schema["example"]; ok {
+		delete(schema, "example")
+		if _, exists := schema["examples"]; !exists {
+			schema["examples"] = []interface{}{example}
+		}
+	}
+
+	if discriminator, ok := schema["discriminator"].(map[string]interface{}); ok {
+		delete(schema, "discriminator")
+		if property, ok := discriminator["propertyName"].(string); ok {
+			required, _ := schema["required"].([]interface{})
+			if !containsValue(required, property) {
+				schema["required"] = append(required, property)
+			}
+		}
+	}
+
+	if deprecated, ok := schema["deprecated"].(bool); ok {
+		delete(schema, "deprecated")
+		if deprecated {
+			schema[deprecatedKeyword] = true
+		}
+	}
+}
+
+// convertExclusiveBound turns the boolean exclusive bound of OpenAPI 3.0, a
+// modifier of bound, into the numeric Draft 7 keyword
+func convertExclusiveBound(schema map[string]interface{}, exclusive, bound string) {
+	flag, ok := schema[exclusive].(bool)
+	if !ok {
+		return
+	}
+
+	delete(schema, exclusive)
+	if limit, ok := schema[bound]; ok && flag {
+		delete(schema, bound)
+		schema[exclusive] = limit
+	}
+}
+
+// containsValue reports whether values holds value
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
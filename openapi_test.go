@@ -0,0 +1,115 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+const testOpenAPISpec = `{
+	"openapi": "3.0.3",
+	"info": {"title": "Pedidos", "version": "1.0.0"},
+	"paths": {},
+	"components": {
+		"schemas": {
+			"Order": {
+				"type": "object",
+				"properties": {
+					"id": {"type": "integer", "minimum": 0, "exclusiveMinimum": true, "example": 42},
+					"note": {"type": "string", "nullable": true},
+					"status": {"type": "string", "enum": ["open", "closed"], "nullable": true},
+					"customer": {"$ref": "#/components/schemas/Customer"},
+					"shipping": {"$ref": "#/components/schemas/Address", "nullable": true},
+					"payment": {
+						"oneOf": [
+							{"$ref": "#/components/schemas/Card"},
+							{"$ref": "#/components/schemas/Pix"}
+						],
+						"discriminator": {"propertyName": "kind"}
+					},
+					"legacyCode": {"type": "string", "deprecated": true}
+				},
+				"required": ["id", "customer"]
+			},
+			"Customer": {
+				"type": "object",
+				"properties": {"name": {"type": "string", "minLength": 2}},
+				"required": ["name"]
+			},
+			"Address": {
+				"type": "object",
+				"properties": {"city": {"type": "string"}},
+				"required": ["city"]
+			},
+			"Card": {
+				"type": "object",
+				"properties": {"kind": {"enum": ["card"]}, "number": {"type": "string"}},
+				"required": ["number"]
+			},
+			"Pix": {
+				"type": "object",
+				"properties": {"kind": {"enum": ["pix"]}, "key": {"type": "string"}},
+				"required": ["key"]
+			}
+		}
+	}
+}`
+
+func TestNewFromOpenAPI(t *testing.T) {
+	validator, err := NewFromOpenAPI([]byte(testOpenAPISpec), "Order")
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		data  string
+		valid bool
+	}{
+		{"pedido completo", `{"id": 1, "customer": {"name": "Ana"}, "shipping": {"city": "Recife"}, "payment": {"kind": "pix", "key": "ana@x.com"}}`, true},
+		{"campos anuláveis nulos", `{"id": 1, "customer": {"name": "Ana"}, "note": null, "status": null, "shipping": null}`, true},
+		{"mínimo exclusivo", `{"id": 0, "customer": {"name": "Ana"}}`, false},
+		{"referência inválida", `{"id": 1, "customer": {"name": "A"}}`, false},
+		{"referência anulável inválida", `{"id": 1, "customer": {"name": "Ana"}, "shipping": {}}`, false},
+		{"enum fora da lista", `{"id": 1, "customer": {"name": "Ana"}, "status": "lost"}`, false},
+		{"discriminador ausente", `{"id": 1, "customer": {"name": "Ana"}, "payment": {"key": "ana@x.com"}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.data)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.valid {
+				t.Errorf("esperava Valid=%v, recebeu %v: %+v", tt.valid, result.Valid, result.Errors)
+			}
+		})
+	}
+
+	// Deprecated properties become x-deprecated
+	if !strings.Contains(string(validator.SchemaBytes()), `"x-deprecated":true`) {
+		t.Errorf("esperava x-deprecated no schema convertido, recebeu %s", validator.SchemaBytes())
+	}
+}
+
+func TestNewFromOpenAPIErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		component string
+		message   string
+	}{
+		{"JSON inválido", `{"components":`, "Order", "especificação OpenAPI inválida"},
+		{"componente ausente", testOpenAPISpec, "Invoice", "componente 'Invoice' não encontrado"},
+		{"referência não suportada", `{"components": {"schemas": {"A": {"$ref": "#/components/parameters/id"}}}}`, "A", "referência '#/components/parameters/id' não suportada"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFromOpenAPI([]byte(tt.spec), tt.component)
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("esperava erro contendo '%s', recebeu %v", tt.message, err)
+			}
+		})
+	}
+}
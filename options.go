@@ -0,0 +1,79 @@
+package valid
+
+// Options settings applied when constructing a validator
+type Options struct {
+	// StrictFormats rejects schemas that use a format with no registered checker
+	StrictFormats bool
+	// CaptureRaw keeps the validated bytes in ValidationResult.Raw
+	CaptureRaw bool
+	// AssertContent validates strings against their contentEncoding (base64) and
+	// contentMediaType (application/json) keywords instead of treating them as annotations
+	AssertContent bool
+	// RejectDuplicateKeys reports objects that repeat a key, which encoding/json
+	// would otherwise silently resolve by keeping the last value
+	RejectDuplicateKeys bool
+	// UseNumber decodes numbers as json.Number in the checks performed outside
+	// gojsonschema, which already receives the document with json.Number values,
+	// so comparisons such as x-greater-than are exact beyond float64 precision
+	UseNumber bool
+	// RedactValues omits the offending values from the produced errors, which may contain PII
+	RedactValues bool
+	// BestMatchOneOf reports, for oneOf/anyOf failures, only the errors of the
+	// closest branch plus a summary error instead of the errors gojsonschema picked
+	BestMatchOneOf bool
+	// WarnDeprecated adds a warning for every present property marked with
+	// x-deprecated in the schema, without rejecting the document
+	WarnDeprecated bool
+	// MeasureTiming records the time spent on each validation in ValidationResult.Duration
+	MeasureTiming bool
+	// FailFast reports only the first error-level violation
+	FailFast bool
+	// MaxErrors reports at most this many violations (default: all)
+	MaxErrors int
+	// CoerceTypes converts string values to the number, integer or boolean type
+	// their schema expects before validating
+	CoerceTypes bool
+	// MaxDepth rejects JSON documents nested deeper than this many levels before
+	// decoding them, whatever the schema allows (default: unlimited)
+	MaxDepth int
+	// Normalize trims and changes the case of string values marked with the
+	// x-trim, x-lowercase and x-uppercase extensions before validating them,
+	// replaces strings matching an enum marked x-enum-case-insensitive with the
+	// enum value as written, and returns the normalized document in
+	// ValidationResult.Normalized
+	Normalize bool
+	// HumanPathSeparator separates the segments of ValidationError.HumanPath (default: " → ")
+	HumanPathSeparator string
+	// StrictInteger rejects numbers written with a fraction or an exponent, such
+	// as 1.0 or 1e2, where the schema expects an integer. It implies UseNumber
+	// for the checks performed outside gojsonschema.
+	StrictInteger bool
+	// SkipJSONPrecheck skips the json.Valid scan done before validating and relies
+	// on gojsonschema's decoding error instead, reported the same way. Trailing
+	// data after the first JSON value is then ignored, as gojsonschema does.
+	SkipJSONPrecheck bool
+	// RejectNonFinite reports numbers that overflow a float64, such as 1e400,
+	// which downstream decoders would turn into ±Inf. It implies UseNumber for
+	// the checks performed outside gojsonschema.
+	RejectNonFinite bool
+	// ResultCacheSize caches up to this many results of ValidateBytes and the
+	// methods built on it, keyed by a hash of the input bytes, so repeated
+	// payloads skip validation (default: no cache). The cache is emptied by
+	// Reload; it is not aware of formats or keywords registered afterwards.
+	ResultCacheSize int
+	// DisabledFormats lists formats that are not checked, whatever the schema
+	// declares, e.g. to turn off uri checks in locked-down deployments. They are
+	// also exempt from StrictFormats, so they need no registered checker.
+	DisabledFormats []string
+	// RejectDisabledFormats rejects every string declared with a disabled format
+	// instead of accepting any value
+	RejectDisabledFormats bool
+	// MaxDocumentBytes rejects JSON documents larger than this many bytes before
+	// parsing them, in every entry point that validates bytes, including
+	// ValidateInterface after marshaling (default: unlimited)
+	MaxDocumentBytes int
+	// RejectBOM reports schemas and documents starting with a UTF-8 byte order
+	// mark as invalid JSON instead of ignoring the mark, which Windows tools
+	// often write at the start of exported files
+	RejectBOM bool
+}
@@ -0,0 +1,266 @@
+package valid
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCaptureRaw(t *testing.T) {
+	payload := `{"name": "Ana", "email": "ana@test.com"}`
+
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err := validator.ValidateString(payload)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Raw != nil {
+		t.Error("Raw deveria ser nil sem CaptureRaw")
+	}
+
+	validator, err = NewFromBytesWithOptions([]byte(testSchema), Options{CaptureRaw: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	for _, data := range []string{payload, `{"name": "A"}`, `{"name": `} {
+		result, err = validator.ValidateString(data)
+		if err != nil {
+			t.Fatalf("não esperava erro, mas recebeu: %v", err)
+		}
+		if string(result.Raw) != data {
+			t.Errorf("esperava Raw '%s', recebeu '%s'", data, result.Raw)
+		}
+	}
+
+	// The middleware result carries the body that was read
+	var captured []byte
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
+			captured = result.Raw
+			w.WriteHeader(http.StatusBadRequest)
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+	})
+
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "A"}`))
+	middleware(httptest.NewRecorder(), req)
+
+	if string(captured) != `{"name": "A"}` {
+		t.Errorf("esperava corpo capturado no resultado, recebeu '%s'", captured)
+	}
+}
+
+func TestUseNumber(t *testing.T) {
+	payload := []byte(`{"id": 9007199254740993, "amount": 0.1000000000000000055511151231257827}`)
+
+	validator, err := NewFromBytes([]byte(`{"type": "object"}`))
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	document, err := validator.decodeDocument(payload)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	// Without UseNumber the 64-bit integer is rounded by float64
+	if id := document.(map[string]interface{})["id"]; id != float64(9007199254740992) {
+		t.Errorf("esperava float64 arredondado sem UseNumber, recebeu %v (%T)", id, id)
+	}
+
+	validator, err = NewFromBytesWithOptions([]byte(`{"type": "object"}`), Options{UseNumber: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	document, err = validator.decodeDocument(payload)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	fields := document.(map[string]interface{})
+	if id, ok := fields["id"].(json.Number); !ok || id.String() != "9007199254740993" {
+		t.Errorf("esperava json.Number 9007199254740993, recebeu %v (%T)", fields["id"], fields["id"])
+	}
+	if amount, ok := fields["amount"].(json.Number); !ok || amount.String() != "0.1000000000000000055511151231257827" {
+		t.Errorf("esperava decimal preservado, recebeu %v (%T)", fields["amount"], fields["amount"])
+	}
+
+	// Post-validation checks see the exact numbers only with UseNumber: the ids
+	// differ by one past 2^53, which float64 rounds to the same value
+	schema := []byte(`{"type": "object", "properties": {"id": {"type": "integer", "x-greater-than": "parentId"}}}`)
+	ids := []byte(`{"id": 9007199254740993, "parentId": 9007199254740992}`)
+
+	for _, useNumber := range []bool{false, true} {
+		validator, err = NewFromBytesWithOptions(schema, Options{UseNumber: useNumber})
+		if err != nil {
+			t.Fatalf("erro ao criar validator: %v", err)
+		}
+		result, err := validator.ValidateBytes(ids)
+		if err != nil {
+			t.Fatalf("não esperava erro, mas recebeu: %v", err)
+		}
+		if result.Valid != useNumber {
+			t.Errorf("UseNumber=%v: esperava valid=%v, recebeu %+v", useNumber, useNumber, result.Errors)
+		}
+	}
+}
+
+func TestRedactValues(t *testing.T) {
+	invalid := `{"name": "Ana", "email": "ana.silva@@empresa", "age": -5}`
+
+	validator, err := NewFromBytesWithOptions([]byte(testSchema), Options{RedactValues: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(invalid)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) == 0 {
+		t.Fatal("esperava dados inválidos")
+	}
+	for _, validationErr := range result.Errors {
+		if validationErr.Value != nil {
+			t.Errorf("campo '%s': esperava Value nil, recebeu %v", validationErr.Field, validationErr.Value)
+		}
+	}
+
+	// Middleware redaction is off by default
+	validator, err = NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	for _, redact := range []bool{false, true} {
+		middleware := validator.MiddlewareWithConfig(MiddlewareConfig{RedactValues: redact}, func(w http.ResponseWriter, r *http.Request) {})
+
+		req := httptest.NewRequest("POST", "/test", strings.NewReader(invalid))
+		w := httptest.NewRecorder()
+		middleware(w, req)
+
+		leaked := strings.Contains(w.Body.String(), "ana.silva@@empresa")
+		if leaked == redact {
+			t.Errorf("RedactValues=%v: valor presente na resposta=%v", redact, leaked)
+		}
+	}
+}
+
+func TestRedactValuesMessages(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"email": {"type": "string", "format": "email", "errorMessage": {"format": "e-mail '{value}' inválido"}},
+			"count": {"type": "integer"},
+			"amount": {"type": "number"},
+			"items": {"type": "array", "x-unique-by": "cpf"}
+		}
+	}`
+	document := `{"email": "ana.silva@@empresa", "count": 7.0, "amount": 1e400, "items": [{"cpf": "12345678900"}, {"cpf": "12345678900"}]}`
+	secrets := []string{"ana.silva@@empresa", "7.0", "1e400", "12345678900"}
+
+	// Both the validator option and the middleware setting redact the messages
+	for _, validatorRedacts := range []bool{true, false} {
+		validator, err := NewFromBytesWithOptions([]byte(schema), Options{RedactValues: validatorRedacts, StrictInteger: true, RejectNonFinite: true})
+		if err != nil {
+			t.Fatalf("erro ao criar validator: %v", err)
+		}
+
+		result, err := validator.ValidateString(document)
+		if err != nil {
+			t.Fatalf("não esperava erro, mas recebeu: %v", err)
+		}
+		if len(result.Errors) < 4 {
+			t.Fatalf("esperava ao menos 4 erros, recebeu %+v", result.Errors)
+		}
+		if !validatorRedacts {
+			result.RedactValues()
+		}
+
+		for _, validationErr := range result.Errors {
+			for _, secret := range secrets {
+				if strings.Contains(validationErr.Message, secret) {
+					t.Errorf("RedactValues option=%v: a mensagem de '%s' contém o valor: %s", validatorRedacts, validationErr.Field, validationErr.Message)
+				}
+			}
+		}
+	}
+}
+
+func TestMeasureTiming(t *testing.T) {
+	document := `{"name": "Ana", "email": "ana@x.com"}`
+
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, _ := validator.ValidateString(document)
+	if result.Duration != 0 {
+		t.Errorf("não esperava duração sem MeasureTiming, recebeu %v", result.Duration)
+	}
+
+	validator, err = NewFromBytesWithOptions([]byte(testSchema), Options{MeasureTiming: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, _ = validator.ValidateString(document)
+	if result.Duration <= 0 {
+		t.Errorf("esperava duração positiva com MeasureTiming, recebeu %v", result.Duration)
+	}
+
+	result, _ = validator.ValidateGoValue(map[string]interface{}{"name": "Ana", "email": "ana@x.com"})
+	if result.Duration <= 0 {
+		t.Errorf("esperava duração positiva em ValidateGoValue, recebeu %v", result.Duration)
+	}
+
+	encoded, _ := json.Marshal(result)
+	if strings.Contains(string(encoded), "uration") {
+		t.Errorf("a duração não deveria ser serializada, recebeu %s", encoded)
+	}
+}
+
+func TestSkipJSONPrecheck(t *testing.T) {
+	checked, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	skipped, err := NewFromBytesWithOptions([]byte(testSchema), Options{SkipJSONPrecheck: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	for _, jsonData := range []string{`{"name":`, `{"name" "Ana"}`, `[1,]`, ` `} {
+		for name, validator := range map[string]*Validator{"precheck": checked, "sem precheck": skipped} {
+			result, err := validator.ValidateString(jsonData)
+			if err != nil {
+				t.Fatalf("%s: não esperava erro para %q, mas recebeu: %v", name, jsonData, err)
+			}
+			if result.Valid || len(result.Errors) != 1 {
+				t.Fatalf("%s: esperava um erro para %q, recebeu %+v", name, jsonData, result.Errors)
+			}
+			validationErr := result.Errors[0]
+			if validationErr.Field != "root" || validationErr.Constraint != "format" || validationErr.Code != codeInvalidJSON {
+				t.Errorf("%s: esperava erro de JSON inválido para %q, recebeu %+v", name, jsonData, validationErr)
+			}
+			if !strings.HasPrefix(validationErr.Message, "JSON inválido: ") {
+				t.Errorf("%s: mensagem inesperada '%s'", name, validationErr.Message)
+			}
+		}
+	}
+
+	result, err := skipped.ValidateString(`{"name": "Ana", "email": "ana@x.com"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava dados válidos, recebeu %+v", result.Errors)
+	}
+}
@@ -0,0 +1,124 @@
+package valid
+
+import (
+	"sort"
+	"strings"
+)
+
+// ValidatePartial validates JSON bytes ignoring the required keywords of the
+// root schema, so only the fields present are checked against their constraints
+func (v *Validator) ValidatePartial(data []byte) (*ValidationResult, error) {
+	return v.ValidatePartialWith(data, false)
+}
+
+// ValidatePartialWith validates JSON bytes ignoring the required keywords of the
+// root schema and, when stripNested is true, of every nested schema as well
+func (v *Validator) ValidatePartialWith(data []byte, stripNested bool) (*ValidationResult, error) {
+	v = v.active()
+
+	key := "partial"
+	if stripNested {
+		key = "partial-nested"
+	}
+
+	partial, _, err := v.derivedValidator(key, func(schema map[string]interface{}) interface{} {
+		stripRequired(schema, stripNested)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return partial.ValidateBytes(data)
+}
+
+// stripRequired removes the required keyword from a schema object. The allOf
+// branches of a schema apply to the same instance, so they are stripped too.
+// When nested is true, every subschema is stripped recursively.
+func stripRequired(schema map[string]interface{}, nested bool) {
+	if nested {
+		walkSubschemas(schema, func(subschema map[string]interface{}) {
+			delete(subschema, "required")
+		})
+		return
+	}
+
+	delete(schema, "required")
+
+	if branches, ok := schema["allOf"].([]interface{}); ok {
+		for _, branch := range branches {
+			if branchMap, ok := branch.(map[string]interface{}); ok {
+				stripRequired(branchMap, false)
+			}
+		}
+	}
+}
+
+// ValidateFields validates JSON bytes against a schema reduced to the listed
+// top-level properties, as in the steps of a multi-step form. The required
+// keywords keep only the listed fields, and the keywords that depend on the
+// whole set of properties (additionalProperties, patternProperties,
+// propertyNames, dependencies, minProperties and maxProperties) are dropped,
+// so fields not listed are ignored even when present.
+func (v *Validator) ValidateFields(data []byte, fields []string) (*ValidationResult, error) {
+	v = v.active()
+
+	listed := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		listed[field] = true
+	}
+
+	names := make([]string, 0, len(listed))
+	for field := range listed {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	subset, _, err := v.derivedValidator("fields:"+strings.Join(names, "\x00"), func(schema map[string]interface{}) interface{} {
+		keepFields(schema, listed)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subset.ValidateBytes(data)
+}
+
+// keepFields reduces a schema object to the listed properties. The allOf
+// branches of a schema apply to the same instance, so they are reduced too.
+func keepFields(schema map[string]interface{}, listed map[string]bool) {
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		for name := range properties {
+			if !listed[name] {
+				delete(properties, name)
+			}
+		}
+	}
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		kept := make([]interface{}, 0, len(required))
+		for _, name := range required {
+			if str, ok := name.(string); ok && listed[str] {
+				kept = append(kept, name)
+			}
+		}
+		if len(kept) == 0 {
+			delete(schema, "required")
+		} else {
+			schema["required"] = kept
+		}
+	}
+
+	for _, keyword := range []string{"additionalProperties", "patternProperties", "propertyNames", "dependencies", "minProperties", "maxProperties"} {
+		delete(schema, keyword)
+	}
+
+	if branches, ok := schema["allOf"].([]interface{}); ok {
+		for _, branch := range branches {
+			if branchMap, ok := branch.(map[string]interface{}); ok {
+				keepFields(branchMap, listed)
+			}
+		}
+	}
+}
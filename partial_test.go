@@ -0,0 +1,154 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidatePartial(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		jsonData    string
+		stripNested bool
+		expectValid bool
+	}{
+		{name: "only one field present", jsonData: `{"age": 30}`, expectValid: true},
+		{name: "present field violates constraint", jsonData: `{"name": "J"}`, expectValid: false},
+		{name: "nested required kept", jsonData: `{"address": {"city": "São Paulo"}}`, expectValid: false},
+		{name: "nested required stripped", jsonData: `{"address": {"city": "São Paulo"}}`, stripNested: true, expectValid: true},
+		{name: "nested constraint still applies", jsonData: `{"address": {"zipCode": "123"}}`, stripNested: true, expectValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidatePartialWith([]byte(tt.jsonData), tt.stripNested)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Errorf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+		})
+	}
+
+	// The full validation still enforces required
+	result, err := validator.ValidateString(`{"age": 30}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Error("validação completa deveria exigir campos obrigatórios")
+	}
+}
+
+func TestMiddlewarePartialMethods(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handlerCalled := false
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		w.WriteHeader(http.StatusOK)
+	}
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		PartialMethods: []string{"PATCH"},
+	}, handler)
+
+	// PATCH with a subset of fields passes
+	req := httptest.NewRequest("PATCH", "/test", strings.NewReader(`{"age": 30}`))
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if !handlerCalled {
+		t.Error("handler deveria ter sido chamado para PATCH parcial")
+	}
+
+	// PUT with the same body is validated in full
+	req = httptest.NewRequest("PUT", "/test", strings.NewReader(`{"age": 30}`))
+	w = httptest.NewRecorder()
+	handlerCalled = false
+	middleware(w, req)
+
+	if handlerCalled {
+		t.Error("handler não deveria ter sido chamado para PUT incompleto")
+	}
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("esperava status 400, recebeu %d", w.Code)
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 2},
+			"email": {"type": "string", "format": "email"},
+			"address": {
+				"type": "object",
+				"properties": {"city": {"type": "string"}},
+				"required": ["city"]
+			},
+			"age": {"type": "integer", "minimum": 18}
+		},
+		"required": ["name", "email", "address"],
+		"additionalProperties": false
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		fields       []string
+		jsonData     string
+		expectValid  bool
+		expectFields []string
+	}{
+		{name: "first step", fields: []string{"name", "email"}, jsonData: `{"name": "Ana", "email": "ana@x.com"}`, expectValid: true},
+		{name: "listed field missing", fields: []string{"name", "email"}, jsonData: `{"name": "Ana"}`, expectFields: []string{""}},
+		{name: "listed field invalid", fields: []string{"name"}, jsonData: `{"name": "A"}`, expectFields: []string{"name"}},
+		{name: "field not listed is ignored", fields: []string{"name"}, jsonData: `{"name": "Ana", "age": 5, "extra": true}`, expectValid: true},
+		{name: "nested required kept", fields: []string{"address"}, jsonData: `{"address": {}}`, expectFields: []string{"address"}},
+		{name: "no fields", fields: nil, jsonData: `{"age": 5}`, expectValid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateFields([]byte(tt.jsonData), tt.fields)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Fatalf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+
+			var fields []string
+			for _, validationErr := range result.Errors {
+				fields = append(fields, validationErr.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.expectFields) {
+				t.Errorf("esperava erros em %v, recebeu %+v", tt.expectFields, result.Errors)
+			}
+		})
+	}
+
+	// The full schema still applies to the other validations
+	result, err := validator.ValidateString(`{"name": "Ana"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Error("esperava erro de campos obrigatórios com o schema completo")
+	}
+}
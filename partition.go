@@ -0,0 +1,66 @@
+package valid
+
+import (
+	"fmt"
+	"sync"
+)
+
+// InvalidDoc is a document rejected by Partition
+type InvalidDoc struct {
+	// Index position of the document in the input
+	Index int
+	// Data original bytes of the document
+	Data []byte
+	// Result violations found in the document
+	Result *ValidationResult
+}
+
+// Partition validates each document and splits them into the valid ones and
+// the invalid ones, both in input order, so bulk imports can accept the good
+// records and report the bad ones in one pass. An error validating any
+// document, such as an empty one, aborts the partition.
+func (v *Validator) Partition(docs [][]byte) ([][]byte, []InvalidDoc, error) {
+	return v.PartitionConcurrent(docs, 1)
+}
+
+// PartitionConcurrent is like Partition, validating up to workers documents at
+// a time with the same compiled schema
+func (v *Validator) PartitionConcurrent(docs [][]byte, workers int) ([][]byte, []InvalidDoc, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]*ValidationResult, len(docs))
+	errs := make([]error, len(docs))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(docs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = v.ValidateBytes(docs[i])
+			}
+		}()
+	}
+	for i := range docs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var valid [][]byte
+	var invalid []InvalidDoc
+	for i, result := range results {
+		if errs[i] != nil {
+			return nil, nil, fmt.Errorf("erro ao validar documento %d: %w", i, errs[i])
+		}
+		if result.Valid {
+			valid = append(valid, docs[i])
+		} else {
+			invalid = append(invalid, InvalidDoc{Index: i, Data: docs[i], Result: result})
+		}
+	}
+	return valid, invalid, nil
+}
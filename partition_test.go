@@ -0,0 +1,53 @@
+package valid
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPartition(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	docs := [][]byte{
+		[]byte(`{"name": "Ana", "email": "ana@x.com"}`),
+		[]byte(`{"name": "A", "email": "ana@x.com"}`),
+		[]byte(`{"name": "Bia", "email": "bia@x.com", "age": 30}`),
+		[]byte(`{"name": "Caio"}`),
+		[]byte(`{"name": `),
+	}
+
+	for _, workers := range []int{1, 3, 10} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			valid, invalid, err := validator.PartitionConcurrent(docs, workers)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+
+			if len(valid) != 2 || string(valid[0]) != string(docs[0]) || string(valid[1]) != string(docs[2]) {
+				t.Errorf("esperava os documentos 0 e 2 válidos, recebeu %q", valid)
+			}
+
+			if len(invalid) != 3 {
+				t.Fatalf("esperava 3 documentos inválidos, recebeu %d", len(invalid))
+			}
+			for i, index := range []int{1, 3, 4} {
+				doc := invalid[i]
+				if doc.Index != index || string(doc.Data) != string(docs[index]) {
+					t.Errorf("esperava documento %d, recebeu %d (%s)", index, doc.Index, doc.Data)
+				}
+				if doc.Result == nil || doc.Result.Valid || len(doc.Result.Errors) == 0 {
+					t.Errorf("documento %d: esperava resultado com erros, recebeu %+v", index, doc.Result)
+				}
+			}
+		})
+	}
+
+	_, _, err = validator.Partition([][]byte{docs[0], {}})
+	if err == nil || !strings.Contains(err.Error(), "documento 1") {
+		t.Errorf("esperava erro no documento 1, recebeu %v", err)
+	}
+}
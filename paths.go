@@ -0,0 +1,89 @@
+package valid
+
+import "sort"
+
+// PropertyPaths returns every property path defined by the schema in dotted
+// notation (e.g. address.zipCode). Array items are marked with [] (e.g.
+// contacts[].email), local references are followed and the properties of
+// definitions are listed under their definitions or $defs prefix.
+func (v *Validator) PropertyPaths() []string {
+	v = v.active()
+
+	seen := make(map[string]bool)
+	root := v.schemaObj
+
+	collectPropertyPaths(root, root, "", seen, make(map[string]bool))
+
+	for _, key := range []string{"definitions", "$defs"} {
+		if defs, ok := root[key].(map[string]interface{}); ok {
+			for name, def := range defs {
+				if defMap, ok := def.(map[string]interface{}); ok {
+					path := key + "." + name
+					refs := map[string]bool{"#/" + key + "/" + name: true}
+					collectPropertyPaths(root, defMap, path, seen, refs)
+				}
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// collectPropertyPaths records the paths of the properties declared by schema.
+// refs holds the references being expanded on the current branch to stop cycles.
+func collectPropertyPaths(root, schema map[string]interface{}, prefix string, seen, refs map[string]bool) {
+	if ref, ok := schema["$ref"].(string); ok {
+		if refs[ref] {
+			return
+		}
+		if resolved, ok := resolveLocalRef(root, ref); ok {
+			refs[ref] = true
+			collectPropertyPaths(root, resolved, prefix, seen, refs)
+			delete(refs, ref)
+		}
+	}
+
+	if props, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, prop := range props {
+			path := joinFieldPath(prefix, name)
+			seen[path] = true
+
+			if propMap, ok := prop.(map[string]interface{}); ok {
+				collectPropertyPaths(root, propMap, path, seen, refs)
+			}
+		}
+	}
+
+	switch items := schema["items"].(type) {
+	case map[string]interface{}:
+		collectPropertyPaths(root, items, prefix+"[]", seen, refs)
+	case []interface{}:
+		for _, item := range items {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				collectPropertyPaths(root, itemMap, prefix+"[]", seen, refs)
+			}
+		}
+	}
+
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		if branches, ok := schema[keyword].([]interface{}); ok {
+			for _, branch := range branches {
+				if branchMap, ok := branch.(map[string]interface{}); ok {
+					collectPropertyPaths(root, branchMap, prefix, seen, refs)
+				}
+			}
+		}
+	}
+
+	for _, keyword := range []string{"if", "then", "else"} {
+		if branchMap, ok := schema[keyword].(map[string]interface{}); ok {
+			collectPropertyPaths(root, branchMap, prefix, seen, refs)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPropertyPaths(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	expected := []string{"address", "address.city", "address.street", "address.zipCode", "age", "email", "name"}
+	if paths := validator.PropertyPaths(); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("esperava %v, recebeu %v", expected, paths)
+	}
+
+	validator, err = NewFromString(`{
+		"type": "object",
+		"definitions": {
+			"Contact": {
+				"type": "object",
+				"properties": {
+					"email": {"type": "string"},
+					"parent": {"$ref": "#/definitions/Contact"}
+				}
+			}
+		},
+		"properties": {
+			"contacts": {"type": "array", "items": {"$ref": "#/definitions/Contact"}},
+			"owner": {"allOf": [{"properties": {"name": {"type": "string"}}}]}
+		}
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	expected = []string{
+		"contacts", "contacts[].email", "contacts[].parent",
+		"definitions.Contact.email", "definitions.Contact.parent",
+		"owner", "owner.name",
+	}
+	if paths := validator.PropertyPaths(); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("esperava %v, recebeu %v", expected, paths)
+	}
+}
@@ -0,0 +1,60 @@
+package valid
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// patternCache holds the compiled regexes of the schema patterns, shared by
+// every validator. gojsonschema compiles pattern once per schema, but the checks
+// performed outside it match patternProperties for every key of the document.
+var patternCache sync.Map // map[string]*regexp.Regexp
+
+// compiledPattern returns the cached regex for pattern, compiling it on first use
+func compiledPattern(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := patternCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	patternCache.Store(pattern, re)
+	return re, nil
+}
+
+// checkPatterns compiles every pattern and patternProperties key of the schema,
+// so a bad regex fails when the validator is built and the error names it along
+// with its location. The compiled regexes are cached for later matches.
+func checkPatterns(schema map[string]interface{}) error {
+	var invalid []string
+
+	check := func(pointer, pattern string) {
+		if _, err := compiledPattern(pattern); err != nil {
+			invalid = append(invalid, fmt.Sprintf("'%s' em '#%s' (%s)", pattern, pointer, err.Error()))
+		}
+	}
+
+	walkSubschemasAt(schema, "", func(pointer string, subschema map[string]interface{}) {
+		if pattern, ok := subschema["pattern"].(string); ok {
+			check(pointer+"/pattern", pattern)
+		}
+		if patternProps, ok := subschema["patternProperties"].(map[string]interface{}); ok {
+			for pattern := range patternProps {
+				check(pointer+"/patternProperties/"+escapePointerToken(pattern), pattern)
+			}
+		}
+	})
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	sort.Strings(invalid)
+	return fmt.Errorf("padrões regex inválidos no schema: %s", strings.Join(invalid, ", "))
+}
@@ -0,0 +1,79 @@
+package valid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInvalidPatternFailsAtConstruction(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"code": {"type": "string", "pattern": "^(?=[A-Z])[A-Z0-9]+$"},
+			"tags": {"type": "object", "patternProperties": {"[a-z": {"type": "string"}}}
+		}
+	}`
+
+	_, err := NewFromString(schema)
+	if err == nil {
+		t.Fatal("esperava erro para padrão regex inválido")
+	}
+	for _, expected := range []string{"^(?=[A-Z])[A-Z0-9]+$", "[a-z"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("esperava que o erro citasse o padrão '%s', recebeu: %v", expected, err)
+		}
+	}
+	for _, location := range []string{"#/properties/code/pattern", "#/properties/tags/patternProperties/[a-z"} {
+		if !strings.Contains(err.Error(), location) {
+			t.Errorf("esperava que o erro citasse a localização '%s', recebeu: %v", location, err)
+		}
+	}
+}
+
+func TestPatternCache(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"labels": {"type": "object", "patternProperties": {"^x-cache-[a-z]+$": {"type": "string", "x-deprecated": true}}}
+		}
+	}`
+
+	validator, err := NewFromBytesWithOptions([]byte(schema), Options{WarnDeprecated: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	// Patterns are compiled at construction
+	if _, ok := patternCache.Load("^x-cache-[a-z]+$"); !ok {
+		t.Error("esperava o padrão compilado em cache após a construção")
+	}
+
+	result, _ := validator.ValidateString(`{"labels": {"x-cache-a": "1", "other": "2"}}`)
+	if len(result.Deprecations()) != 1 || result.Deprecations()[0].Field != "labels.x-cache-a" {
+		t.Errorf("esperava aviso para a propriedade casada pelo padrão, recebeu %+v", result.Errors)
+	}
+}
+
+func TestValidateBytesRecoversFromPanics(t *testing.T) {
+	MustRegisterFormat("panics", func(input interface{}) bool {
+		panic("checker com defeito")
+	})
+	defer UnregisterFormat("panics")
+
+	validator, err := NewFromString(`{"type": "object", "properties": {"id": {"type": "string", "format": "panics"}}}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"id": "abc"}`)
+	if err == nil || result != nil {
+		t.Fatalf("esperava erro em vez de panic, recebeu %+v, %v", result, err)
+	}
+	if !strings.Contains(err.Error(), "checker com defeito") {
+		t.Errorf("esperava o motivo do panic no erro, recebeu: %v", err)
+	}
+
+	if _, err := validator.ValidateGoValue(map[string]interface{}{"id": "abc"}); err == nil {
+		t.Error("esperava erro em vez de panic em ValidateGoValue")
+	}
+}
@@ -0,0 +1,120 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ValidatorPool holds reusable scratch state for PooledValidate. Each Validator
+// has its own, and the zero value is ready to use.
+type ValidatorPool struct {
+	readers sync.Pool // *bytes.Reader wrapping the document being decoded
+}
+
+// reader returns a pooled reader positioned at the start of data
+func (p *ValidatorPool) reader(data []byte) *bytes.Reader {
+	reader, ok := p.readers.Get().(*bytes.Reader)
+	if !ok {
+		reader = new(bytes.Reader)
+	}
+	reader.Reset(data)
+	return reader
+}
+
+// release returns a reader to the pool, dropping its reference to the document
+func (p *ValidatorPool) release(reader *bytes.Reader) {
+	reader.Reset(nil)
+	p.readers.Put(reader)
+}
+
+// PooledValidate validates JSON bytes like ValidateBytes with fewer
+// allocations, for services doing tens of thousands of validations per second.
+//
+// The document is decoded once, with a pooled reader, and the decoded tree is
+// handed to gojsonschema and to the post-validation checks, instead of being
+// scanned by json.Valid and decoded again by each of them. Validators using
+// options that rewrite or re-read the bytes (ResultCacheSize, MaxDocumentBytes, MaxDepth,
+// CoerceTypes, Normalize, RejectDuplicateKeys) fall back to ValidateBytes.
+func (v *Validator) PooledValidate(data []byte) (*ValidationResult, error) {
+	v = v.active()
+
+	if v.results != nil || v.opts.MaxDocumentBytes > 0 || v.opts.MaxDepth > 0 || v.opts.CoerceTypes || v.opts.Normalize || v.opts.RejectDuplicateKeys {
+		return v.ValidateBytes(data)
+	}
+
+	var start time.Time
+	if v.opts.MeasureTiming {
+		start = time.Now()
+	}
+
+	data = v.documentBytes(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("dados JSON não podem estar vazios")
+	}
+
+	document, ok := v.decodePooled(data)
+	var result *ValidationResult
+	if !ok {
+		// Decoded again only to describe the error as ValidateBytes does
+		var jsonObj interface{}
+		result = malformedJSON(json.Unmarshal(data, &jsonObj))
+	} else {
+		schemaResult, err := v.runSchema(gojsonschema.NewRawLoader(document))
+		if err != nil {
+			return nil, err
+		}
+		result = v.buildValidationResult(schemaResult)
+
+		if v.needsDocument() {
+			if !v.usesNumberDocument() {
+				// The post-validation checks expect float64 numbers
+				if err := v.postValidate(data, result); err != nil {
+					return nil, err
+				}
+			} else {
+				v.postValidateDocument(document, result)
+			}
+		}
+	}
+
+	v.finalizeResult(result)
+	limitErrors(result, v.callOptions(nil).maxErrors)
+
+	if v.opts.CaptureRaw {
+		result.Raw = data
+	}
+	if v.opts.MeasureTiming {
+		result.Duration = time.Since(start)
+	}
+	return result, nil
+}
+
+// decodePooled decodes a single JSON value with numbers as json.Number, as
+// gojsonschema expects, reporting false for malformed JSON or trailing data
+func (v *Validator) decodePooled(data []byte) (interface{}, bool) {
+	reader := v.pool.reader(data)
+	defer v.pool.release(reader)
+
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, false
+	}
+	if len(bytes.TrimSpace(data[decoder.InputOffset():])) > 0 {
+		return nil, false
+	}
+	return document, true
+}
+
+// usesNumberDocument reports whether the post-validation checks decode
+// numbers as json.Number
+func (v *Validator) usesNumberDocument() bool {
+	return v.opts.UseNumber || v.opts.StrictInteger || v.opts.RejectNonFinite
+}
@@ -0,0 +1,120 @@
+package valid
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPooledValidate(t *testing.T) {
+	documents := []string{
+		`{"name": "Ana", "email": "ana@x.com", "age": 30}`,
+		`{"name": "A", "email": "invalido", "age": -1}`,
+		`{"name": "Ana", "email": "ana@x.com", "age": 30.0}`,
+		`{"name": "Ana", "email": "ana@x.com", "age": 1e400}`,
+		`{"name": "Ana", "email": "ana@x.com"} {"extra": true}`,
+		`{"name": `,
+		`[1,]`,
+	}
+
+	for name, opts := range map[string]Options{
+		"padrão":          {},
+		"StrictInteger":   {StrictInteger: true},
+		"RejectNonFinite": {RejectNonFinite: true},
+		"WarnDeprecated":  {WarnDeprecated: true},
+		"CoerceTypes":     {CoerceTypes: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			validator, err := NewFromBytesWithOptions([]byte(testSchema), opts)
+			if err != nil {
+				t.Fatalf("erro ao criar validator: %v", err)
+			}
+
+			for _, document := range documents {
+				expected, expectedErr := validator.ValidateBytes([]byte(document))
+				pooled, err := validator.PooledValidate([]byte(document))
+				if (err == nil) != (expectedErr == nil) {
+					t.Errorf("%s: esperava erro %v, recebeu %v", document, expectedErr, err)
+					continue
+				}
+				if !reflect.DeepEqual(pooled, expected) {
+					t.Errorf("%s: resultado diferente de ValidateBytes:\nesperado: %+v\nrecebido: %+v", document, expected, pooled)
+				}
+			}
+		})
+	}
+
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	if _, err := validator.PooledValidate(nil); err == nil {
+		t.Error("esperava erro para dados vazios")
+	}
+}
+
+// BenchmarkPooledValidateLargeDocument is BenchmarkValidateBytesLargeDocument
+// through PooledValidate, run with -benchmem to compare allocs/op
+func BenchmarkPooledValidateLargeDocument(b *testing.B) {
+	validator, err := NewFromString(`{
+		"type": "array",
+		"items": ` + testSchema + `
+	}`)
+	if err != nil {
+		b.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	items := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		items = append(items, `{"name": "João Silva", "email": "joao@exemplo.com", "age": 30}`)
+	}
+	validJSON := []byte("[" + strings.Join(items, ",") + "]")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := validator.PooledValidate(validJSON)
+		if err != nil {
+			b.Fatalf("erro durante benchmark: %v", err)
+		}
+	}
+}
+
+// BenchmarkPooledValidateStrictInteger compares with BenchmarkValidateBytesStrictInteger
+// the cost of decoding the document once for gojsonschema and the post-validation checks
+func BenchmarkPooledValidateStrictInteger(b *testing.B) {
+	benchmarkStrictInteger(b, func(v *Validator, data []byte) (*ValidationResult, error) {
+		return v.PooledValidate(data)
+	})
+}
+
+func BenchmarkValidateBytesStrictInteger(b *testing.B) {
+	benchmarkStrictInteger(b, func(v *Validator, data []byte) (*ValidationResult, error) {
+		return v.ValidateBytes(data)
+	})
+}
+
+func benchmarkStrictInteger(b *testing.B, validate func(v *Validator, data []byte) (*ValidationResult, error)) {
+	validator, err := NewFromBytesWithOptions([]byte(`{
+		"type": "array",
+		"items": `+testSchema+`
+	}`), Options{StrictInteger: true})
+	if err != nil {
+		b.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	items := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		items = append(items, `{"name": "João Silva", "email": "joao@exemplo.com", "age": 30}`)
+	}
+	validJSON := []byte("[" + strings.Join(items, ",") + "]")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := validate(validator, validJSON)
+		if err != nil {
+			b.Fatalf("erro durante benchmark: %v", err)
+		}
+	}
+}
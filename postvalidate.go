@@ -0,0 +1,200 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// isMalformed reports whether the result describes a document that is not valid JSON
+func isMalformed(result *ValidationResult) bool {
+	return len(result.Errors) == 1 && result.Errors[0].Code == codeInvalidJSON
+}
+
+// postValidate runs the checks enabled by options that gojsonschema does not
+// perform, appending their errors to the result
+func (v *Validator) postValidate(jsonData []byte, result *ValidationResult) error {
+	if v.opts.RejectDuplicateKeys {
+		duplicates, err := v.findDuplicateKeys(jsonData)
+		if err != nil {
+			return err
+		}
+		v.appendErrors(result, duplicates)
+	}
+
+	if !v.needsDocument() {
+		return nil
+	}
+
+	document, err := v.decodeDocument(jsonData)
+	if err != nil {
+		return err
+	}
+
+	v.postValidateDocument(document, result)
+	return nil
+}
+
+// needsDocument reports whether any enabled check inspects the decoded document
+func (v *Validator) needsDocument() bool {
+	return v.opts.AssertContent || v.opts.BestMatchOneOf || v.opts.WarnDeprecated || len(v.activeKeywords()) > 0 ||
+		v.usesComparisons() || v.crossRules.len() > 0 || v.opts.StrictInteger || v.opts.RejectNonFinite ||
+		(v.opts.RejectDisabledFormats && len(v.opts.DisabledFormats) > 0)
+}
+
+// postValidateDocument runs the checks that inspect the decoded document
+func (v *Validator) postValidateDocument(document interface{}, result *ValidationResult) {
+	if v.opts.BestMatchOneOf && !result.Valid {
+		v.selectBestMatch(document, result)
+	}
+
+	if v.opts.AssertContent {
+		v.appendErrors(result, v.assertContent(document))
+	}
+
+	if v.opts.StrictInteger {
+		v.appendErrors(result, v.checkStrictIntegers(document))
+	}
+
+	if v.opts.RejectNonFinite {
+		v.appendErrors(result, v.findNonFinite(document))
+	}
+
+	if v.opts.RejectDisabledFormats && len(v.opts.DisabledFormats) > 0 {
+		v.appendErrors(result, v.checkDisabledFormats(document))
+	}
+
+	if keywords := v.activeKeywords(); len(keywords) > 0 {
+		v.appendErrors(result, v.checkKeywords(document, keywords))
+	}
+
+	v.appendErrors(result, v.checkCrossFields(document))
+
+	if v.opts.WarnDeprecated {
+		// Warnings do not change the validity of the result
+		result.Errors = append(result.Errors, v.findDeprecated(document)...)
+	}
+}
+
+// decodeDocument decodes JSON bytes for the checks performed outside gojsonschema.
+// With the UseNumber option numbers are kept as json.Number, preserving the
+// precision of large integers and decimals that float64 cannot represent.
+func (v *Validator) decodeDocument(jsonData []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	if v.usesNumberDocument() {
+		dec.UseNumber()
+	}
+
+	var document interface{}
+	if err := dec.Decode(&document); err != nil {
+		return nil, fmt.Errorf("erro ao decodificar documento: %w", err)
+	}
+	return document, nil
+}
+
+// appendErrors adds errors to the result, marking it invalid when there are any
+func (v *Validator) appendErrors(result *ValidationResult, errs []ValidationError) {
+	if len(errs) == 0 {
+		return
+	}
+
+	result.Errors = append(result.Errors, errs...)
+	result.Valid = false
+}
+
+// walkDocument calls fn for every value of the document together with its dotted
+// field path and the schemas that apply to it, as returned by appliedSchemas
+func (v *Validator) walkDocument(document interface{}, fn func(path string, value interface{}, schemas []map[string]interface{})) {
+	root := v.schemaObj
+	v.walkDocumentNode(v.appliedSchemas(root, root, document, 0), document, "", fn)
+}
+
+func (v *Validator) walkDocumentNode(schemas []map[string]interface{}, value interface{}, path string, fn func(path string, value interface{}, schemas []map[string]interface{})) {
+	fn(path, value, schemas)
+
+	appliedTo := func(child interface{}) func(schema map[string]interface{}) []map[string]interface{} {
+		return func(schema map[string]interface{}) []map[string]interface{} {
+			return v.appliedSchemas(v.schemaObj, schema, child, 0)
+		}
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			v.walkDocumentNode(propertySchemasWith(schemas, key, appliedTo(child)), child, joinFieldPath(path, key), fn)
+		}
+	case []interface{}:
+		for i, child := range typed {
+			v.walkDocumentNode(itemSchemasWith(schemas, i, appliedTo(child)), child, joinFieldPath(path, fmt.Sprint(i)), fn)
+		}
+	}
+}
+
+// appliedSchemas resolves local references and returns the schema together with
+// the composition branches that apply to value: every allOf branch and the
+// anyOf/oneOf branches value matches, so the keywords of an alternative the
+// value did not take are not checked. When value matches no branch it already
+// fails the composition, and every branch is returned.
+func (v *Validator) appliedSchemas(root, schema map[string]interface{}, value interface{}, depth int) []map[string]interface{} {
+	if schema == nil || depth > maxExpandDepth {
+		return nil
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		if resolved, ok := resolveLocalRef(root, ref); ok {
+			return v.appliedSchemas(root, resolved, value, depth+1)
+		}
+	}
+
+	applied := []map[string]interface{}{schema}
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		branches, ok := schema[keyword].([]interface{})
+		if !ok {
+			continue
+		}
+
+		var matched map[int]bool
+		if keyword != "allOf" {
+			matched = v.matchingBranches(branches, value)
+		}
+
+		for i, branch := range branches {
+			if branchMap, ok := branch.(map[string]interface{}); ok && (matched == nil || matched[i]) {
+				applied = append(applied, v.appliedSchemas(root, branchMap, value, depth+1)...)
+			}
+		}
+	}
+
+	return applied
+}
+
+// matchingBranches returns the indexes of the branches value satisfies, or nil
+// when it satisfies none or they can not be evaluated
+func (v *Validator) matchingBranches(branches []interface{}, value interface{}) map[int]bool {
+	normalized, ok, err := normalizeGoValue(value)
+	if !ok || err != nil {
+		return nil
+	}
+
+	var matched map[int]bool
+	for i, branch := range branches {
+		branchValidator, err := v.branchValidator(branch)
+		if err != nil {
+			return nil
+		}
+
+		result, err := branchValidator.runSchema(gojsonschema.NewRawLoader(normalized))
+		if err != nil {
+			return nil
+		}
+		if result.Valid() {
+			if matched == nil {
+				matched = make(map[int]bool)
+			}
+			matched[i] = true
+		}
+	}
+	return matched
+}
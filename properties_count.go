@@ -0,0 +1,35 @@
+package valid
+
+import (
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// propertyCount returns the number of properties of the object that violated
+// minProperties or maxProperties
+func propertyCount(err gojsonschema.ResultError) (int, bool) {
+	if err.Type() != "array_min_properties" && err.Type() != "array_max_properties" {
+		return 0, false
+	}
+
+	object, ok := err.Value().(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	return len(object), true
+}
+
+// propertyCountMessage builds the message of a minProperties or maxProperties
+// violation, stating the expected bound and the actual count
+func propertyCountMessage(err gojsonschema.ResultError) (string, bool) {
+	count, ok := propertyCount(err)
+	if !ok {
+		return "", false
+	}
+
+	if err.Type() == "array_min_properties" {
+		return fmt.Sprintf("o objeto deve ter no mínimo %v propriedade(s), mas possui %d", err.Details()["min"], count), true
+	}
+	return fmt.Sprintf("o objeto deve ter no máximo %v propriedade(s), mas possui %d", err.Details()["max"], count), true
+}
@@ -0,0 +1,53 @@
+package valid
+
+import "testing"
+
+const propertyCountSchema = `{
+	"type": "object",
+	"minProperties": 2,
+	"properties": {
+		"name": {"type": "string"},
+		"metadata": {
+			"type": "object",
+			"minProperties": 1,
+			"maxProperties": 2,
+			"errorMessage": {"minProperties": "metadata não pode ser vazio"}
+		},
+		"tags": {"type": "object", "maxProperties": 1}
+	}
+}`
+
+func TestPropertyCountErrors(t *testing.T) {
+	validator, err := NewFromString(propertyCountSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "Ana"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 {
+		t.Fatalf("esperava um erro de minProperties, recebeu %+v", result.Errors)
+	}
+
+	validationErr := result.Errors[0]
+	expected := "o objeto deve ter no mínimo 2 propriedade(s), mas possui 1"
+	if validationErr.Message != expected {
+		t.Errorf("esperava mensagem '%s', recebeu '%s'", expected, validationErr.Message)
+	}
+	if validationErr.Value != 1 {
+		t.Errorf("esperava a contagem de propriedades como valor, recebeu %v", validationErr.Value)
+	}
+
+	// Custom message from errorMessage.minProperties
+	result, _ = validator.ValidateString(`{"name": "Ana", "metadata": {}}`)
+	if len(result.Errors) != 1 || result.Errors[0].Message != "metadata não pode ser vazio" {
+		t.Errorf("esperava mensagem personalizada, recebeu %+v", result.Errors)
+	}
+
+	result, _ = validator.ValidateString(`{"name": "Ana", "tags": {"a": 1, "b": 2}}`)
+	if len(result.Errors) != 1 || result.Errors[0].Message != "o objeto deve ter no máximo 1 propriedade(s), mas possui 2" {
+		t.Errorf("esperava mensagem de maxProperties, recebeu %+v", result.Errors)
+	}
+}
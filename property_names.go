@@ -0,0 +1,67 @@
+package valid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// propertyNameFormat is the default message of a propertyNames violation
+const propertyNameFormat = "o nome de propriedade '%s' é inválido"
+
+// propertyName returns the key that violated propertyNames
+func propertyName(err gojsonschema.ResultError) (string, bool) {
+	if err.Type() != "invalid_property_name" {
+		return "", false
+	}
+
+	name, ok := err.Details()["property"].(string)
+	return name, ok
+}
+
+// propertyNameMessage builds the default message of a propertyNames violation, naming the key
+func propertyNameMessage(err gojsonschema.ResultError) (string, bool) {
+	name, ok := propertyName(err)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf(propertyNameFormat, name), true
+}
+
+// foldPropertyNameErrors removes the errors gojsonschema reports for the key
+// itself after a propertyNames violation, such as a pattern mismatch reported
+// on the object with the key as value. Their messages are appended to the
+// default message of the violation, custom messages are kept as written.
+func foldPropertyNameErrors(errs []ValidationError) []ValidationError {
+	folded := errs[:0]
+	parent, reasons := -1, []string(nil)
+
+	flush := func() {
+		if parent >= 0 && len(reasons) > 0 {
+			folded[parent].Message += ": " + strings.Join(reasons, "; ")
+		}
+		parent, reasons = -1, nil
+	}
+
+	for _, validationErr := range errs {
+		if parent >= 0 && validationErr.Field == folded[parent].Field {
+			if value, ok := validationErr.Value.(string); ok && value == folded[parent].Value {
+				name, _ := folded[parent].Value.(string)
+				if folded[parent].Message == fmt.Sprintf(propertyNameFormat, name) || len(reasons) > 0 {
+					reasons = append(reasons, validationErr.Message)
+				}
+				continue
+			}
+		}
+
+		flush()
+		folded = append(folded, validationErr)
+		if validationErr.Constraint == "invalid_property_name" {
+			parent = len(folded) - 1
+		}
+	}
+	flush()
+
+	return folded
+}
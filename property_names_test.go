@@ -0,0 +1,74 @@
+package valid
+
+import "testing"
+
+func TestPropertyNames(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {
+			"meta": {"type": "object", "propertyNames": {"pattern": "^[a-z_]+$", "maxLength": 10}},
+			"labels": {
+				"type": "object",
+				"propertyNames": {"pattern": "^[a-z]+$"},
+				"errorMessage": {"propertyNames": "as chaves de labels devem ter apenas letras minúsculas"}
+			}
+		}
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		jsonData      string
+		expectValid   bool
+		expectValue   string
+		expectMessage string
+	}{
+		{name: "valid keys", jsonData: `{"meta": {"ok": 1, "snake_case": 2}}`, expectValid: true},
+		{
+			name:          "invalid key",
+			jsonData:      `{"meta": {"ok": 1, "Bad-Key": 2}}`,
+			expectValue:   "Bad-Key",
+			expectMessage: "o nome de propriedade 'Bad-Key' é inválido: Does not match pattern '^[a-z_]+$'",
+		},
+		{
+			name:          "key failing two constraints",
+			jsonData:      `{"meta": {"Very-Long-Key": 1}}`,
+			expectValue:   "Very-Long-Key",
+			expectMessage: "o nome de propriedade 'Very-Long-Key' é inválido: String length must be less than or equal to 10; Does not match pattern '^[a-z_]+$'",
+		},
+		{
+			name:          "custom message",
+			jsonData:      `{"labels": {"Env": "prod"}}`,
+			expectValue:   "Env",
+			expectMessage: "as chaves de labels devem ter apenas letras minúsculas",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Fatalf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+			if tt.expectValid {
+				return
+			}
+
+			if len(result.Errors) != 1 {
+				t.Fatalf("esperava 1 erro, recebeu %+v", result.Errors)
+			}
+			validationErr := result.Errors[0]
+			if validationErr.Constraint != "invalid_property_name" || validationErr.Value != tt.expectValue {
+				t.Errorf("esperava erro de propertyNames com valor '%s', recebeu %+v", tt.expectValue, validationErr)
+			}
+			if validationErr.Message != tt.expectMessage {
+				t.Errorf("esperava mensagem '%s', recebeu '%s'", tt.expectMessage, validationErr.Message)
+			}
+		})
+	}
+}
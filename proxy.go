@@ -0,0 +1,18 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httputil"
+)
+
+// ProxyMiddleware returns a handler that validates each request and forwards
+// the valid ones to proxy, so validation can run at the edge in front of
+// upstream services. Invalid requests are answered by the ErrorHandler and
+// never reach the upstream. The body read for validation is rewound, so the
+// proxy forwards it unchanged.
+//
+// The options are those of MiddlewareFunc. WithMultipartJSONField should not
+// be used, as parsing the multipart form consumes the body before it is proxied.
+func (v *Validator) ProxyMiddleware(proxy *httputil.ReverseProxy, opts ...MiddlewareOption) http.Handler {
+	return v.MiddlewareFunc(opts...)(proxy.ServeHTTP)
+}
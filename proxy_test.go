@@ -0,0 +1,56 @@
+package valid
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestProxyMiddleware(t *testing.T) {
+	var received []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, string(body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer upstream.Close()
+
+	target, err := url.Parse(upstream.URL)
+	if err != nil {
+		t.Fatalf("erro ao interpretar URL: %v", err)
+	}
+
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	gateway := httptest.NewServer(validator.ProxyMiddleware(httputil.NewSingleHostReverseProxy(target)))
+	defer gateway.Close()
+
+	valid := `{"name": "Ana", "email": "ana@x.com"}`
+	resp, err := http.Post(gateway.URL+"/users", "application/json", strings.NewReader(valid))
+	if err != nil {
+		t.Fatalf("erro na requisição: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("esperava status 201 do upstream, recebeu %d", resp.StatusCode)
+	}
+
+	resp, err = http.Post(gateway.URL+"/users", "application/json", strings.NewReader(`{"name": "A"}`))
+	if err != nil {
+		t.Fatalf("erro na requisição: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("esperava status 400 do gateway, recebeu %d", resp.StatusCode)
+	}
+
+	if len(received) != 1 || received[0] != valid {
+		t.Errorf("esperava apenas o corpo válido encaminhado intacto, recebeu %q", received)
+	}
+}
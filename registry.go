@@ -0,0 +1,16 @@
+package valid
+
+// DefaultRegistry is the process-wide registry of named validators used by
+// Register and Lookup, in the spirit of http.DefaultServeMux
+var DefaultRegistry = NewMultiValidator()
+
+// Register adds a validator to DefaultRegistry under name, replacing any
+// validator previously registered with the same name
+func Register(name string, validator *Validator) {
+	DefaultRegistry.Add(name, validator)
+}
+
+// Lookup returns the validator registered in DefaultRegistry under name
+func Lookup(name string) (*Validator, bool) {
+	return DefaultRegistry.Get(name)
+}
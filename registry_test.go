@@ -0,0 +1,41 @@
+package valid
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestDefaultRegistry(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	Register("test-user", validator)
+	defer DefaultRegistry.Remove("test-user")
+
+	found, ok := Lookup("test-user")
+	if !ok || found != validator {
+		t.Error("esperava encontrar o validator registrado")
+	}
+	if _, ok := Lookup("inexistente"); ok {
+		t.Error("não esperava encontrar validator não registrado")
+	}
+
+	// Concurrent registrations and lookups
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("test-concurrent-%d", i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Register(name, validator)
+			if _, ok := Lookup(name); !ok {
+				t.Errorf("esperava encontrar '%s'", name)
+			}
+			DefaultRegistry.Remove(name)
+		}()
+	}
+	wg.Wait()
+}
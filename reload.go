@@ -0,0 +1,41 @@
+package valid
+
+// Reload replaces the schema of the validator in place: the new bytes are
+// parsed, their custom messages and codes extracted and the schema compiled
+// before being swapped in, keeping the options, the base location of relative
+// $refs and the cross-field rules. Validations already running finish with the
+// previous schema. When the new bytes are invalid an error is returned and the
+// previous schema stays in use.
+func (v *Validator) Reload(schemaBytes []byte) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	previous := v
+	if v.current != nil {
+		previous = v.current
+	}
+
+	schemaCopy := make([]byte, len(schemaBytes))
+	copy(schemaCopy, schemaBytes)
+
+	fresh, err := newValidator(schemaCopy, previous.opts, previous.schemaURI, previous.store)
+	if err != nil {
+		return err
+	}
+	fresh.crossRules = previous.crossRules
+
+	v.current = fresh
+	return nil
+}
+
+// active returns the validator holding the schema in use, which is the one
+// built by the last Reload, if any
+func (v *Validator) active() *Validator {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if v.current != nil {
+		return v.current
+	}
+	return v
+}
@@ -0,0 +1,87 @@
+package valid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestReload(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	document := `{"name": "Ana", "email": "ana@x.com"}`
+	if result, _ := validator.ValidateString(document); !result.Valid {
+		t.Fatalf("esperava documento válido antes do reload, recebeu %+v", result.Errors)
+	}
+	oldHash := validator.SchemaHash()
+
+	newSchema := `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 5, "errorMessage": {"minLength": "nome muito curto"}}
+		},
+		"required": ["name", "phone"]
+	}`
+	if err := validator.Reload([]byte(newSchema)); err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	result, _ := validator.ValidateString(document)
+	if result.Valid || !result.HasFieldError("name") {
+		t.Fatalf("esperava validação com o novo schema, recebeu %+v", result.Errors)
+	}
+	for _, validationErr := range result.Errors {
+		if validationErr.Field == "name" && validationErr.Message != "nome muito curto" {
+			t.Errorf("esperava a mensagem personalizada do novo schema, recebeu '%s'", validationErr.Message)
+		}
+	}
+	if validator.SchemaHash() == oldHash || string(validator.SchemaBytes()) != newSchema {
+		t.Error("hash e bytes deveriam refletir o novo schema")
+	}
+
+	// Derived validators are rebuilt from the new schema
+	result, _ = validator.ValidatePartial([]byte(`{"name": "Ana"}`))
+	if result.Valid {
+		t.Error("esperava erro de minLength do novo schema na validação parcial")
+	}
+
+	// Invalid bytes keep the current schema
+	if err := validator.Reload([]byte(`{"type": 42}`)); err == nil {
+		t.Error("esperava erro para schema inválido")
+	}
+	if err := validator.Reload([]byte(`{invalid`)); err == nil {
+		t.Error("esperava erro para JSON inválido")
+	}
+	if string(validator.SchemaBytes()) != newSchema {
+		t.Error("schema anterior deveria continuar em uso após reload inválido")
+	}
+}
+
+func TestReloadConcurrent(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := validator.ValidateString(`{"name": "Ana", "email": "ana@x.com"}`); err != nil {
+					t.Errorf("não esperava erro, mas recebeu: %v", err)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if err := validator.Reload([]byte(testSchema)); err != nil {
+				t.Errorf("não esperava erro, mas recebeu: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,114 @@
+package valid
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+)
+
+// resultCache is a concurrency-safe LRU of validation results keyed by the
+// hash of the validated bytes and the settings of the call
+type resultCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // Most recently used first, holding resultCacheEntry values
+	entries map[resultCacheKey]*list.Element
+}
+
+// resultCacheKey identifies a validation: the same bytes validated with other
+// call options or cross-field rules may produce a different result
+type resultCacheKey struct {
+	sum          [sha256.Size]byte
+	maxErrors    int
+	coerceTypes  bool
+	rulesVersion uint64
+}
+
+// resultCacheEntry is a cached result along with its key, to evict it
+type resultCacheEntry struct {
+	key    resultCacheKey
+	result *ValidationResult
+}
+
+// newResultCache creates a cache holding up to size results, or nil when size
+// is zero or less so that caching is disabled
+func newResultCache(size int) *resultCache {
+	if size <= 0 {
+		return nil
+	}
+	return &resultCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[resultCacheKey]*list.Element),
+	}
+}
+
+// cacheKey returns the key of a validation of jsonData with settings
+func (v *Validator) cacheKey(jsonData []byte, settings callOptions) resultCacheKey {
+	return resultCacheKey{
+		sum:          sha256.Sum256(jsonData),
+		maxErrors:    settings.maxErrors,
+		coerceTypes:  settings.coerceTypes,
+		rulesVersion: v.crossRules.generation(),
+	}
+}
+
+// get returns a copy of the cached result for key
+func (c *resultCache) get(key resultCacheKey) (*ValidationResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return cloneResult(element.Value.(resultCacheEntry).result), true
+}
+
+// put caches a copy of result for key, evicting the least recently used one when full
+func (c *resultCache) put(key resultCacheKey, result *ValidationResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := resultCacheEntry{key: key, result: cloneResult(result)}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(resultCacheEntry).key)
+	}
+}
+
+// cloneResult copies a result so callers may change the copy, as RedactValues
+// does, without affecting the cached one. Raw and Duration belong to a single
+// call and are left out.
+func cloneResult(result *ValidationResult) *ValidationResult {
+	clone := &ValidationResult{
+		Valid:      result.Valid,
+		Normalized: result.Normalized,
+	}
+	if result.Errors != nil {
+		clone.Errors = make([]ValidationError, len(result.Errors))
+		for i, validationErr := range result.Errors {
+			if validationErr.Fields != nil {
+				validationErr.Fields = append([]string(nil), validationErr.Fields...)
+			}
+			if validationErr.Details != nil {
+				details := make(map[string]interface{}, len(validationErr.Details))
+				for key, value := range validationErr.Details {
+					details[key] = value
+				}
+				validationErr.Details = details
+			}
+			clone.Errors[i] = validationErr
+		}
+	}
+	return clone
+}
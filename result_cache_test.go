@@ -0,0 +1,101 @@
+package valid
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestResultCache(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(testSchema), Options{ResultCacheSize: 2})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	invalid := []byte(`{"name": "A"}`)
+	first, err := validator.ValidateBytes(invalid)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if first.Valid {
+		t.Fatal("esperava dados inválidos")
+	}
+
+	// Changing a returned result does not affect the cached one
+	first.RedactValues()
+	second, _ := validator.ValidateBytes(invalid)
+	if second.Valid || len(second.Errors) != len(first.Errors) {
+		t.Fatalf("esperava o mesmo resultado do cache, recebeu %+v", second.Errors)
+	}
+	if second.Errors[0].Value == nil {
+		t.Error("o resultado em cache não deveria ter sido alterado pelo chamador")
+	}
+
+	// Call options are part of the key
+	limited, _ := validator.ValidateBytesContext(context.Background(), invalid, MaxErrors(1))
+	if len(limited.Errors) != 1 {
+		t.Errorf("esperava 1 erro com MaxErrors(1), recebeu %d", len(limited.Errors))
+	}
+
+	// Cross-field rules added afterwards are applied
+	validator.AddCrossFieldRule("sempre", func(document map[string]interface{}) []ValidationError {
+		return []ValidationError{{Field: "name", Message: "regra cruzada"}}
+	})
+	ruled, _ := validator.ValidateBytes(invalid)
+	if len(ruled.Errors) != len(first.Errors)+1 {
+		t.Errorf("esperava o erro da regra cruzada, recebeu %+v", ruled.Errors)
+	}
+
+	// Reload empties the cache
+	if err := validator.Reload([]byte(`{"type": "object"}`)); err != nil {
+		t.Fatalf("erro ao recarregar schema: %v", err)
+	}
+	reloaded, _ := validator.ValidateBytes(invalid)
+	if len(reloaded.Errors) != 1 || reloaded.Errors[0].Field != "name" {
+		t.Errorf("esperava apenas o erro da regra cruzada após Reload, recebeu %+v", reloaded.Errors)
+	}
+}
+
+func TestResultCacheEviction(t *testing.T) {
+	cache := newResultCache(2)
+	keys := make([]resultCacheKey, 3)
+	for i := range keys {
+		keys[i] = resultCacheKey{maxErrors: i}
+	}
+
+	cache.put(keys[0], &ValidationResult{Valid: true})
+	cache.put(keys[1], &ValidationResult{Valid: true})
+	cache.get(keys[0])
+	cache.put(keys[2], &ValidationResult{Valid: true})
+
+	if _, ok := cache.get(keys[1]); ok {
+		t.Error("o resultado menos usado deveria ter sido removido")
+	}
+	for _, key := range []resultCacheKey{keys[0], keys[2]} {
+		if _, ok := cache.get(key); !ok {
+			t.Errorf("esperava resultado em cache para %+v", key)
+		}
+	}
+}
+
+func TestResultCacheConcurrent(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(testSchema), Options{ResultCacheSize: 8})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			data := fmt.Sprintf(`{"name": "Ana", "email": "ana%d@x.com"}`, i%12)
+			result, err := validator.ValidateString(data)
+			if err != nil || !result.Valid {
+				t.Errorf("esperava dados válidos para %s, recebeu %v %+v", data, err, result)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,249 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// sampleMaxDepth stops the expansion of recursive schemas
+const sampleMaxDepth = 16
+
+// sampleFormats are the values generated for strings with a known format
+var sampleFormats = map[string]string{
+	"email":                 "user@example.com",
+	"idn-email":             "user@example.com",
+	"date-time":             "2024-01-01T00:00:00Z",
+	"date":                  "2024-01-01",
+	"time":                  "00:00:00Z",
+	"uri":                   "https://example.com",
+	"iri":                   "https://example.com",
+	"uri-reference":         "https://example.com",
+	"iri-reference":         "https://example.com",
+	"uri-template":          "https://example.com/{id}",
+	"hostname":              "example.com",
+	"idn-hostname":          "example.com",
+	"ipv4":                  "192.0.2.1",
+	"ipv6":                  "2001:db8::1",
+	"uuid":                  "00000000-0000-0000-0000-000000000000",
+	"json-pointer":          "/",
+	"relative-json-pointer": "0",
+	"regex":                 ".*",
+}
+
+// SampleData generates a minimal document valid against the schema, for
+// documentation examples and test fixtures.
+//
+// Each value is taken from const, default, example, the first of examples or
+// the first of enum when present. Otherwise objects hold only their required
+// properties, arrays minItems items, strings a value of their format or padded
+// to minLength, and numbers the smallest value allowed by their bounds and
+// multipleOf. oneOf and anyOf use their first branch and local $refs are
+// followed. The generated document is validated before being returned; an
+// error is returned when it does not pass, as for strings with a pattern.
+func (v *Validator) SampleData() ([]byte, error) {
+	v = v.active()
+
+	sample := sampleValue(v.schemaObj, v.schemaObj, 0)
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao serializar exemplo: %w", err)
+	}
+
+	result, err := v.ValidateBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid {
+		return nil, fmt.Errorf("não foi possível gerar um exemplo válido: %s", result.Summary())
+	}
+	return data, nil
+}
+
+// sampleValue generates a minimal value for schema
+func sampleValue(root, schema map[string]interface{}, depth int) interface{} {
+	if depth > sampleMaxDepth {
+		return nil
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		if resolved, ok := resolveLocalRef(root, ref); ok {
+			return sampleValue(root, resolved, depth+1)
+		}
+	}
+
+	for _, key := range []string{"const", "default", "example"} {
+		if value, ok := schema[key]; ok {
+			return value
+		}
+	}
+	for _, key := range []string{"examples", "enum"} {
+		if values, ok := schema[key].([]interface{}); ok && len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if branches, ok := schema[key].([]interface{}); ok && len(branches) > 0 {
+			if branch, ok := branches[0].(map[string]interface{}); ok {
+				return mergeSample(sampleValue(root, branch, depth+1), sampleByType(root, schema, depth))
+			}
+		}
+	}
+
+	sample := sampleByType(root, schema, depth)
+	if branches, ok := schema["allOf"].([]interface{}); ok {
+		for _, branch := range branches {
+			if branchMap, ok := branch.(map[string]interface{}); ok {
+				sample = mergeSample(sample, sampleValue(root, branchMap, depth+1))
+			}
+		}
+	}
+	return sample
+}
+
+// mergeSample combines the samples of a schema and of one of its subschemas:
+// objects are merged, otherwise the first non-nil sample wins
+func mergeSample(sample, other interface{}) interface{} {
+	object, ok := sample.(map[string]interface{})
+	otherObject, otherOK := other.(map[string]interface{})
+	if ok && otherOK {
+		for key, value := range otherObject {
+			if _, exists := object[key]; !exists {
+				object[key] = value
+			}
+		}
+		return object
+	}
+
+	if sample != nil {
+		return sample
+	}
+	return other
+}
+
+// sampleByType generates a minimal value of the type declared by schema,
+// inferred from its keywords when absent
+func sampleByType(root, schema map[string]interface{}, depth int) interface{} {
+	switch sampleType(schema) {
+	case "object":
+		object := make(map[string]interface{})
+		properties, _ := schema["properties"].(map[string]interface{})
+		required, _ := schema["required"].([]interface{})
+		for _, name := range required {
+			name, ok := name.(string)
+			if !ok {
+				continue
+			}
+			property, _ := properties[name].(map[string]interface{})
+			object[name] = sampleValue(root, property, depth+1)
+		}
+		return object
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		minItems, _ := schema["minItems"].(float64)
+		array := make([]interface{}, int(minItems))
+		for i := range array {
+			array[i] = sampleValue(root, items, depth+1)
+		}
+		return array
+	case "string":
+		return sampleString(schema)
+	case "integer":
+		return sampleNumber(schema, true)
+	case "number":
+		return sampleNumber(schema, false)
+	case "boolean":
+		return false
+	default:
+		return nil
+	}
+}
+
+// sampleType returns the first non-null type of schema, or the type implied by its keywords
+func sampleType(schema map[string]interface{}) string {
+	switch typed := schema["type"].(type) {
+	case string:
+		return typed
+	case []interface{}:
+		for _, t := range typed {
+			if name, ok := t.(string); ok && name != "null" {
+				return name
+			}
+		}
+		return "null"
+	}
+
+	switch {
+	case schema["properties"] != nil || schema["required"] != nil:
+		return "object"
+	case schema["items"] != nil:
+		return "array"
+	case schema["minLength"] != nil || schema["maxLength"] != nil || schema["format"] != nil:
+		return "string"
+	case schema["minimum"] != nil || schema["maximum"] != nil || schema["multipleOf"] != nil:
+		return "number"
+	}
+	return ""
+}
+
+// sampleString generates a string of the schema format, or padded to minLength
+func sampleString(schema map[string]interface{}) string {
+	if format, ok := schema["format"].(string); ok {
+		if value, ok := sampleFormats[format]; ok {
+			return value
+		}
+	}
+
+	value := "string"
+	if minLength, ok := schema["minLength"].(float64); ok && len(value) < int(minLength) {
+		value += strings.Repeat("x", int(minLength)-len(value))
+	}
+	if maxLength, ok := schema["maxLength"].(float64); ok && len(value) > int(maxLength) {
+		value = value[:int(maxLength)]
+	}
+	return value
+}
+
+// sampleNumber generates the smallest number allowed by the bounds and
+// multipleOf of schema, or zero when they allow it
+func sampleNumber(schema map[string]interface{}, integer bool) interface{} {
+	value := 0.0
+	lower, hasLower := schema["minimum"].(float64)
+	if exclusive, ok := schema["exclusiveMinimum"].(float64); ok && (!hasLower || exclusive >= lower) {
+		lower, hasLower = exclusive+sampleStep(integer), true
+	}
+	upper, hasUpper := schema["maximum"].(float64)
+	if exclusive, ok := schema["exclusiveMaximum"].(float64); ok && (!hasUpper || exclusive <= upper) {
+		upper, hasUpper = exclusive-sampleStep(integer), true
+	}
+
+	switch {
+	case hasLower && value < lower:
+		value = lower
+	case hasUpper && value > upper:
+		value = upper
+	}
+
+	step, hasStep := schema["multipleOf"].(float64)
+	if hasStep && step > 0 {
+		value = math.Ceil(value/step) * step
+	}
+	if integer {
+		value = math.Ceil(value)
+		if hasStep && step > 0 && math.Mod(value, step) != 0 {
+			value = math.Ceil(value/step) * step
+		}
+		return int64(value)
+	}
+	return value
+}
+
+// sampleStep is the distance kept from an exclusive bound
+func sampleStep(integer bool) float64 {
+	if integer {
+		return 1
+	}
+	return 0.5
+}
@@ -0,0 +1,76 @@
+package valid
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSampleData(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 10},
+			"email": {"type": "string", "format": "email"},
+			"age": {"type": "integer", "minimum": 18, "multipleOf": 5},
+			"score": {"type": "number", "exclusiveMinimum": 0, "maximum": 10},
+			"role": {"enum": ["admin", "user"]},
+			"country": {"type": "string", "default": "BR"},
+			"active": {"type": "boolean"},
+			"tags": {"type": "array", "items": {"type": "string"}, "minItems": 2},
+			"address": {"$ref": "#/definitions/address"},
+			"contact": {"oneOf": [{"type": "string", "format": "uri"}, {"type": "integer"}]},
+			"nickname": {"type": "string"}
+		},
+		"required": ["name", "email", "age", "score", "role", "country", "active", "tags", "address", "contact"],
+		"definitions": {
+			"address": {
+				"type": "object",
+				"properties": {"zip": {"type": "string", "example": "01001-000"}, "city": {"type": "string"}},
+				"required": ["zip"]
+			}
+		}
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	data, err := validator.SampleData()
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	var sample map[string]interface{}
+	if err := json.Unmarshal(data, &sample); err != nil {
+		t.Fatalf("exemplo não é JSON válido: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"name":    "stringxxxx",
+		"email":   "user@example.com",
+		"age":     float64(20),
+		"score":   0.5,
+		"role":    "admin",
+		"country": "BR",
+		"active":  false,
+		"tags":    []interface{}{"string", "string"},
+		"address": map[string]interface{}{"zip": "01001-000"},
+		"contact": "https://example.com",
+	}
+	if !reflect.DeepEqual(sample, expected) {
+		t.Errorf("exemplo inesperado:\nesperado: %v\nrecebido: %v", expected, sample)
+	}
+}
+
+func TestSampleDataInvalid(t *testing.T) {
+	validator, err := NewFromString(`{"type": "object", "properties": {"code": {"type": "string", "pattern": "^[A-Z]{3}$"}}, "required": ["code"]}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	_, err = validator.SampleData()
+	if err == nil || !strings.Contains(err.Error(), "não foi possível gerar um exemplo válido") {
+		t.Errorf("esperava erro de exemplo inválido, recebeu %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package valid
+
+import (
+	"math/rand/v2"
+	"net/http"
+)
+
+// sampleRequest reports whether a request is validated under config.SampleRate,
+// notifying config.SampleHook. A rate of zero, the default, or of one or more
+// validates every request.
+func sampleRequest(r *http.Request, config MiddlewareConfig) bool {
+	sampled := config.SampleRate <= 0 || config.SampleRate >= 1 || rand.Float64() < config.SampleRate
+
+	if config.SampleHook != nil {
+		config.SampleHook(r, sampled)
+	}
+	return sampled
+}
@@ -0,0 +1,70 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareSampleRate(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	const requests = 2000
+	sampled, skipped, handled := 0, 0, 0
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		SampleRate: 0.1,
+		SampleHook: func(r *http.Request, isSampled bool) {
+			if isSampled {
+				sampled++
+			} else {
+				skipped++
+			}
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		handled++
+	})
+
+	for i := 0; i < requests; i++ {
+		req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "T"}`))
+		middleware(httptest.NewRecorder(), req)
+	}
+
+	if sampled+skipped != requests {
+		t.Errorf("esperava %d requisições no hook, recebeu %d", requests, sampled+skipped)
+	}
+	// Invalid requests only reach the handler when they are not sampled
+	if handled != skipped {
+		t.Errorf("esperava o handler chamado %d vezes, recebeu %d", skipped, handled)
+	}
+	if sampled < requests/20 || sampled > requests/5 {
+		t.Errorf("esperava cerca de 10%% das requisições validadas, recebeu %d de %d", sampled, requests)
+	}
+}
+
+func TestMiddlewareSampleRateDefault(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	for _, rate := range []float64{0, 1} {
+		handlerCalled := false
+		middleware := validator.MiddlewareWithConfig(MiddlewareConfig{SampleRate: rate}, func(w http.ResponseWriter, r *http.Request) {
+			handlerCalled = true
+		})
+
+		for i := 0; i < 50; i++ {
+			req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "T"}`))
+			middleware(httptest.NewRecorder(), req)
+		}
+
+		if handlerCalled {
+			t.Errorf("com SampleRate %v todas as requisições deveriam ser validadas", rate)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package valid
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// schemaContentType is the media type used to serve JSON Schemas
+const schemaContentType = "application/schema+json"
+
+// SchemaHandler returns an HTTP handler that serves the raw schema bytes with
+// an ETag, answering conditional requests with 304 Not Modified
+func (v *Validator) SchemaHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v := v.active()
+
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "Método não permitido", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("ETag", v.schemaETag)
+
+		if etagMatches(r.Header.Get("If-None-Match"), v.schemaETag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", schemaContentType)
+		w.WriteHeader(http.StatusOK)
+
+		if r.Method == http.MethodGet {
+			w.Write(v.schemaBytes)
+		}
+	}
+}
+
+// SchemaHandler returns an HTTP handler that serves the schema of the validator
+// selected by keyFn, responding 404 when the key is not registered
+func (mv *MultiValidator) SchemaHandler(keyFn func(r *http.Request) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validator, exists := mv.Get(keyFn(r))
+		if !exists {
+			http.NotFound(w, r)
+			return
+		}
+
+		validator.SchemaHandler()(w, r)
+	}
+}
+
+// schemaETag returns a strong ETag derived from the SHA-256 of the schema bytes
+func schemaETag(schemaBytes []byte) string {
+	sum := sha256.Sum256(schemaBytes)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches etag
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
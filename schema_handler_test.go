@@ -0,0 +1,94 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSchemaHandler(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handler := validator.SchemaHandler()
+
+	req := httptest.NewRequest("GET", "/schema", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("esperava status 200, recebeu %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/schema+json" {
+		t.Errorf("esperava Content-Type 'application/schema+json', recebeu '%s'", ct)
+	}
+	if w.Body.String() != testSchema {
+		t.Error("corpo da resposta deveria ser o schema original")
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("resposta deveria ter ETag")
+	}
+
+	// Conditional GET with a matching ETag
+	req = httptest.NewRequest("GET", "/schema", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	handler(w, req)
+
+	if w.Code != http.StatusNotModified {
+		t.Errorf("esperava status 304, recebeu %d", w.Code)
+	}
+	if w.Body.Len() != 0 {
+		t.Error("resposta 304 não deveria ter corpo")
+	}
+
+	// Conditional GET with a stale ETag
+	req = httptest.NewRequest("GET", "/schema", nil)
+	req.Header.Set("If-None-Match", `"outro"`)
+	w = httptest.NewRecorder()
+	handler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("esperava status 200, recebeu %d", w.Code)
+	}
+
+	// Unsupported method
+	req = httptest.NewRequest("POST", "/schema", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("esperava status 405, recebeu %d", w.Code)
+	}
+}
+
+func TestMultiValidatorSchemaHandler(t *testing.T) {
+	mv := NewMultiValidator()
+	if err := mv.AddFromString("user", testSchema); err != nil {
+		t.Fatalf("erro ao adicionar validator: %v", err)
+	}
+
+	handler := mv.SchemaHandler(func(r *http.Request) string {
+		return r.URL.Query().Get("name")
+	})
+
+	req := httptest.NewRequest("GET", "/schemas?name=user", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("esperava status 200, recebeu %d", w.Code)
+	}
+
+	req = httptest.NewRequest("GET", "/schemas?name=inexistente", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("esperava status 404, recebeu %d", w.Code)
+	}
+}
@@ -0,0 +1,54 @@
+package valid
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// SchemaBytes returns a copy of the schema bytes the validator was built from
+func (v *Validator) SchemaBytes() []byte {
+	v = v.active()
+
+	schemaBytes := make([]byte, len(v.schemaBytes))
+	copy(schemaBytes, v.schemaBytes)
+	return schemaBytes
+}
+
+// SchemaHash returns the SHA-256 hex digest of the normalized schema. Formatting
+// and key order do not affect the hash, so equivalent schemas hash the same.
+func (v *Validator) SchemaHash() string {
+	return v.active().schemaHash
+}
+
+// schemaVersion returns the $id of the schema, or its hash when it has none
+func (v *Validator) schemaVersion() string {
+	v = v.active()
+
+	if id, ok := v.schemaObj["$id"].(string); ok && id != "" {
+		return id
+	}
+	return v.schemaHash
+}
+
+// normalizedHash hashes the schema re-encoded with sorted keys and no
+// insignificant whitespace, keeping numbers exactly as written
+func normalizedHash(schemaBytes []byte) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(schemaBytes))
+	dec.UseNumber()
+
+	var schema interface{}
+	if err := dec.Decode(&schema); err != nil {
+		return "", fmt.Errorf("schema JSON inválido: %w", err)
+	}
+
+	normalized, err := json.Marshal(schema)
+	if err != nil {
+		return "", fmt.Errorf("erro ao normalizar schema: %w", err)
+	}
+
+	sum := sha256.Sum256(normalized)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,69 @@
+package valid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSchemaBytesAndHash(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	if !bytes.Equal(validator.SchemaBytes(), []byte(testSchema)) {
+		t.Error("SchemaBytes deveria retornar o schema original")
+	}
+
+	// The returned slice is a copy
+	schemaBytes := validator.SchemaBytes()
+	schemaBytes[0] = 'x'
+	if validator.SchemaBytes()[0] == 'x' {
+		t.Error("alterar o retorno de SchemaBytes não deveria afetar o validator")
+	}
+
+	// The validator does not alias the buffer it was created from
+	buffer := []byte(testSchema)
+	owned, err := NewFromBytes(buffer)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	ownedHash := owned.SchemaHash()
+	copy(buffer, bytes.Repeat([]byte(" "), len(buffer)))
+	if !bytes.Equal(owned.SchemaBytes(), []byte(testSchema)) {
+		t.Error("reutilizar o buffer do chamador não deveria alterar SchemaBytes")
+	}
+	if owned.SchemaHash() != ownedHash {
+		t.Error("SchemaHash deveria continuar correspondendo a SchemaBytes")
+	}
+
+	hash := validator.SchemaHash()
+	if len(hash) != 64 {
+		t.Errorf("esperava hash SHA-256 hex com 64 caracteres, recebeu '%s'", hash)
+	}
+
+	// Formatting and key order do not change the hash
+	a, err := NewFromString(`{"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}}}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	b, err := NewFromString(`{
+		"properties": {"name": {"type": "string"}},
+		"required": ["name"],
+		"type": "object"
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	if a.SchemaHash() != b.SchemaHash() {
+		t.Error("schemas equivalentes deveriam ter o mesmo hash")
+	}
+
+	c, err := NewFromString(`{"type": "object", "required": ["email"], "properties": {"name": {"type": "string"}}}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	if a.SchemaHash() == c.SchemaHash() {
+		t.Error("schemas diferentes deveriam ter hashes diferentes")
+	}
+}
@@ -0,0 +1,57 @@
+package valid
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// SchemaValidator is the set of validation methods of *Validator, so handlers
+// can depend on it and tests can substitute a fake
+type SchemaValidator interface {
+	ValidateBytes(jsonData []byte) (*ValidationResult, error)
+	ValidateString(jsonString string) (*ValidationResult, error)
+	ValidateInterface(data interface{}) (*ValidationResult, error)
+	ValidateRequest(r *http.Request) (*ValidationResult, error)
+}
+
+var _ SchemaValidator = (*Validator)(nil)
+
+// MiddlewareFor returns an HTTP middleware validating requests with any
+// SchemaValidator. A *Validator gets the same middleware as
+// MiddlewareWithConfig. Other implementations validate the body with
+// ValidateRequest, so the settings that need the compiled schema are ignored:
+// PartialMethods, Direction, AllowEmptyBody, MultipartJSONField, Timeout,
+// HeaderSchema, GraphQLVariables and IncludeMeta.
+func MiddlewareFor(validator SchemaValidator, config MiddlewareConfig, next http.HandlerFunc) http.HandlerFunc {
+	if v, ok := validator.(*Validator); ok {
+		return v.MiddlewareWithConfig(config, next)
+	}
+
+	config = middlewareDefaults(config)
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = (*Validator)(nil).defaultErrorHandler(config)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if skipValidation(r, config) {
+			next(w, r)
+			return
+		}
+
+		reportBodyRead := limitRequestBody(w, r, config)
+
+		validation, err := validator.ValidateRequest(r)
+		reportBodyRead()
+
+		if err != nil {
+			if bodyTooLarge(w, err) {
+				return
+			}
+			http.Error(w, fmt.Sprintf("Erro interno de validação: %s", err.Error()),
+				http.StatusInternalServerError)
+			return
+		}
+
+		handleValidation(w, r, config, validation, next)
+	}
+}
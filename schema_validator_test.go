@@ -0,0 +1,52 @@
+package valid_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	valid "github.com/raywall/json-schema-validation"
+)
+
+// fakeValidator rejects every request, to test the handler error path without a schema
+type fakeValidator struct{}
+
+func (fakeValidator) ValidateBytes(jsonData []byte) (*valid.ValidationResult, error) {
+	return &valid.ValidationResult{
+		Valid:  false,
+		Errors: []valid.ValidationError{{Field: "name", Message: "nome inválido", Severity: valid.SeverityError}},
+	}, nil
+}
+
+func (f fakeValidator) ValidateString(jsonString string) (*valid.ValidationResult, error) {
+	return f.ValidateBytes([]byte(jsonString))
+}
+
+func (f fakeValidator) ValidateInterface(data interface{}) (*valid.ValidationResult, error) {
+	return f.ValidateBytes(nil)
+}
+
+func (f fakeValidator) ValidateRequest(r *http.Request) (*valid.ValidationResult, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return f.ValidateBytes(body)
+}
+
+func ExampleMiddlewareFor() {
+	handler := valid.MiddlewareFor(fakeValidator{}, valid.MiddlewareConfig{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest("POST", "/users", strings.NewReader(`{"name": "Ana"}`)))
+
+	var response valid.ErrorResponse
+	json.NewDecoder(w.Body).Decode(&response)
+	fmt.Println(w.Code, response.Details[0].Field, response.Details[0].Message)
+	// Output: 400 name nome inválido
+}
@@ -0,0 +1,121 @@
+package valid
+
+import (
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Severity indicates whether a violation blocks the document or is only reported
+type Severity string
+
+const (
+	// SeverityError violations make the document invalid
+	SeverityError Severity = "error"
+	// SeverityWarning violations are reported but keep the document valid
+	SeverityWarning Severity = "warning"
+)
+
+// severityKeyword is the schema extension that sets the severity of a field's violations
+const severityKeyword = "x-severity"
+
+// Warnings returns the warning-level violations of the result
+func (vr *ValidationResult) Warnings() []ValidationError {
+	var warnings []ValidationError
+	for _, validationErr := range vr.Errors {
+		if validationErr.Severity == SeverityWarning {
+			warnings = append(warnings, validationErr)
+		}
+	}
+	return warnings
+}
+
+// finalizeResult applies the settings that shape every produced result:
+// severities, the validity derived from them, human paths and value redaction
+func (v *Validator) finalizeResult(result *ValidationResult) {
+	if len(result.Errors) > 0 {
+		result.Valid = true
+		for i := range result.Errors {
+			if result.Errors[i].Severity == "" {
+				result.Errors[i].Severity = v.severity(result.Errors[i])
+			}
+			if result.Errors[i].Severity == SeverityError {
+				result.Valid = false
+			}
+			result.Errors[i].HumanPath = humanPath(result.Errors[i].Field, v.opts.HumanPathSeparator)
+		}
+	}
+
+	if v.opts.RedactValues {
+		result.RedactValues()
+	}
+}
+
+// severity returns the severity configured in the schema for a violation.
+// Malformed documents are always errors.
+func (v *Validator) severity(validationErr ValidationError) Severity {
+	if validationErr.Code == codeInvalidJSON {
+		return SeverityError
+	}
+
+	baseField := v.schemaFieldKey(validationErr.Field)
+	if fieldSeverities, ok := v.severities[baseField]; ok {
+		if severity, ok := fieldSeverities[validationErr.Constraint]; ok {
+			return severity
+		}
+		if severity, ok := fieldSeverities[constraintKeyword(validationErr.Constraint)]; ok {
+			return severity
+		}
+		if severity, ok := fieldSeverities["_"]; ok {
+			return severity
+		}
+	}
+
+	return SeverityError
+}
+
+// extractSeverities extracts the x-severity extension from the properties of the
+// schema. It may be a string, applied to every constraint of the field, or an
+// object keyed by constraint.
+func extractSeverities(schema map[string]interface{}) map[string]map[string]Severity {
+	severities := make(map[string]map[string]Severity)
+
+	for _, props := range schemaPropertyMaps(schema) {
+		for field, prop := range props {
+			propMap, ok := prop.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch value := propMap[severityKeyword].(type) {
+			case string:
+				severities[field] = map[string]Severity{"_": parseSeverity(value)}
+			case map[string]interface{}:
+				fieldSeverities := make(map[string]Severity)
+				for key, entry := range value {
+					if entryStr, ok := entry.(string); ok {
+						fieldSeverities[key] = parseSeverity(entryStr)
+					}
+				}
+				severities[field] = fieldSeverities
+			}
+		}
+	}
+
+	return severities
+}
+
+// parseSeverity converts a schema value to a Severity, treating unknown values as errors
+func parseSeverity(value string) Severity {
+	if strings.EqualFold(value, string(SeverityWarning)) {
+		return SeverityWarning
+	}
+	return SeverityError
+}
+
+// defaultWarningHandler logs the warnings of a request that passed validation
+func defaultWarningHandler(r *http.Request, warnings []ValidationError) {
+	for _, warning := range warnings {
+		log.Printf("aviso de validação em %s %s: campo '%s': %s", r.Method, r.URL.Path, warning.Field, warning.Message)
+	}
+}
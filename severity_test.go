@@ -0,0 +1,90 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const severitySchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string", "minLength": 2},
+		"nickname": {"type": "string", "maxLength": 5, "x-severity": "warning"},
+		"age": {"type": "integer", "minimum": 18, "maximum": 120, "x-severity": {"minimum": "warning"}}
+	},
+	"required": ["name"]
+}`
+
+func TestSeverity(t *testing.T) {
+	validator, err := NewFromString(severitySchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		jsonData       string
+		expectValid    bool
+		expectWarnings int
+		expectErrors   int
+	}{
+		{name: "no violations", jsonData: `{"name": "Ana"}`, expectValid: true},
+		{name: "warning only", jsonData: `{"name": "Ana", "nickname": "Aninha"}`, expectValid: true, expectWarnings: 1, expectErrors: 1},
+		{name: "per constraint warning", jsonData: `{"name": "Ana", "age": 16}`, expectValid: true, expectWarnings: 1, expectErrors: 1},
+		{name: "per constraint error", jsonData: `{"name": "Ana", "age": 130}`, expectValid: false, expectErrors: 1},
+		{name: "warning and error", jsonData: `{"name": "A", "nickname": "Aninha"}`, expectValid: false, expectWarnings: 1, expectErrors: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Errorf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+			if len(result.Errors) != tt.expectErrors {
+				t.Errorf("esperava %d violações, recebeu %d", tt.expectErrors, len(result.Errors))
+			}
+			if warnings := result.Warnings(); len(warnings) != tt.expectWarnings {
+				t.Errorf("esperava %d avisos, recebeu %d", tt.expectWarnings, len(warnings))
+			}
+			for _, validationErr := range result.Errors {
+				if validationErr.Severity != SeverityError && validationErr.Severity != SeverityWarning {
+					t.Errorf("severidade inesperada '%s'", validationErr.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestMiddlewareWarnings(t *testing.T) {
+	validator, err := NewFromString(severitySchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handlerCalled := false
+	var received []ValidationError
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		WarningHandler: func(r *http.Request, warnings []ValidationError) {
+			received = warnings
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "Ana", "nickname": "Aninha"}`))
+	middleware(httptest.NewRecorder(), req)
+
+	if !handlerCalled {
+		t.Error("handler deveria ter sido chamado quando há apenas avisos")
+	}
+	if len(received) != 1 || received[0].Field != "nickname" {
+		t.Errorf("esperava aviso para 'nickname', recebeu %+v", received)
+	}
+}
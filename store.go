@@ -0,0 +1,85 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// storeBaseURI is the base location of the schemas of a SchemaStore, so a
+// $ref such as "address" or "address#/definitions/zip" resolves to a stored schema
+const storeBaseURI = "store:///"
+
+// SchemaStore holds named schemas that validators built with
+// NewFromBytesWithStore reference by name, with no file or network access.
+// It is safe for concurrent use.
+type SchemaStore struct {
+	mu      sync.RWMutex
+	schemas map[string][]byte
+}
+
+// NewSchemaStore creates an empty schema store
+func NewSchemaStore() *SchemaStore {
+	return &SchemaStore{schemas: make(map[string][]byte)}
+}
+
+// Add stores a schema under name, replacing any schema stored under the same
+// name. Validators already built keep the schemas they were compiled with.
+func (s *SchemaStore) Add(name string, schemaBytes []byte) error {
+	if name == "" {
+		return fmt.Errorf("nome do schema não pode estar vazio")
+	}
+	if !json.Valid(schemaBytes) {
+		return fmt.Errorf("schema '%s' não é um JSON válido", name)
+	}
+
+	schemaCopy := make([]byte, len(schemaBytes))
+	copy(schemaCopy, schemaBytes)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.schemas == nil {
+		s.schemas = make(map[string][]byte)
+	}
+	s.schemas[name] = schemaCopy
+	return nil
+}
+
+// Names returns the names of the stored schemas, sorted
+func (s *SchemaStore) Names() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.schemas))
+	for name := range s.schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// addTo registers the stored schemas in a gojsonschema loader
+func (s *SchemaStore) addTo(loader *gojsonschema.SchemaLoader) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for name, schemaBytes := range s.schemas {
+		if err := loader.AddSchema(storeBaseURI+name, gojsonschema.NewBytesLoader(schemaBytes)); err != nil {
+			return fmt.Errorf("schema '%s' do store inválido: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// NewFromBytesWithStore creates a validator from bytes of a JSON Schema whose
+// $refs name schemas of the store, e.g. {"$ref": "address"}
+func NewFromBytesWithStore(schemaBytes []byte, store *SchemaStore) (*Validator, error) {
+	if store == nil {
+		return nil, fmt.Errorf("store de schemas não pode ser nil")
+	}
+	return newValidator(schemaBytes, Options{}, "", store)
+}
@@ -0,0 +1,99 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewFromBytesWithStore(t *testing.T) {
+	store := NewSchemaStore()
+	if err := store.Add("address", []byte(`{
+		"type": "object",
+		"properties": {"city": {"type": "string"}, "zip": {"$ref": "#/definitions/zip"}},
+		"required": ["city"],
+		"definitions": {"zip": {"type": "string", "pattern": "^[0-9]{5}$"}}
+	}`)); err != nil {
+		t.Fatalf("erro ao adicionar schema: %v", err)
+	}
+	if err := store.Add("money", []byte(`{"type": "number", "minimum": 0}`)); err != nil {
+		t.Fatalf("erro ao adicionar schema: %v", err)
+	}
+
+	validator, err := NewFromBytesWithStore([]byte(`{
+		"type": "object",
+		"properties": {
+			"address": {"$ref": "address"},
+			"zip": {"$ref": "address#/definitions/zip"},
+			"total": {"$ref": "money"}
+		},
+		"required": ["address"]
+	}`), store)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		jsonData     string
+		expectValid  bool
+		expectFields []string
+	}{
+		{name: "valid", jsonData: `{"address": {"city": "Recife", "zip": "50000"}, "total": 10}`, expectValid: true},
+		{name: "stored schema required", jsonData: `{"address": {}}`, expectFields: []string{"address"}},
+		{name: "stored schema local ref", jsonData: `{"address": {"city": "Recife", "zip": "abc"}}`, expectFields: []string{"address.zip"}},
+		{name: "fragment of stored schema", jsonData: `{"address": {"city": "Recife"}, "zip": "abc"}`, expectFields: []string{"zip"}},
+		{name: "second stored schema", jsonData: `{"address": {"city": "Recife"}, "total": -1}`, expectFields: []string{"total"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Fatalf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+
+			var fields []string
+			for _, validationErr := range result.Errors {
+				fields = append(fields, validationErr.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.expectFields) {
+				t.Errorf("esperava erros em %v, recebeu %+v", tt.expectFields, result.Errors)
+			}
+		})
+	}
+
+	// A reloaded schema still resolves the store
+	if err := validator.Reload([]byte(`{"properties": {"total": {"$ref": "money"}}}`)); err != nil {
+		t.Fatalf("erro ao recarregar schema: %v", err)
+	}
+	result, err := validator.ValidateString(`{"total": -1}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Field != "total" {
+		t.Errorf("esperava apenas o erro de 'total', recebeu %+v", result.Errors)
+	}
+}
+
+func TestSchemaStoreErrors(t *testing.T) {
+	store := NewSchemaStore()
+	if err := store.Add("", []byte(`{}`)); err == nil {
+		t.Error("esperava erro para nome vazio")
+	}
+	if err := store.Add("broken", []byte(`{`)); err == nil {
+		t.Error("esperava erro para JSON inválido")
+	}
+	if names := store.Names(); len(names) != 0 {
+		t.Errorf("não esperava schemas no store, recebeu %v", names)
+	}
+
+	if _, err := NewFromBytesWithStore([]byte(`{"$ref": "missing"}`), store); err == nil {
+		t.Error("esperava erro para referência a schema ausente do store")
+	}
+	if _, err := NewFromBytesWithStore([]byte(`{}`), nil); err == nil {
+		t.Error("esperava erro para store nil")
+	}
+}
@@ -0,0 +1,35 @@
+package valid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ValidateMultiStream validates every top-level JSON value of a stream, whether
+// separated by whitespace or sent back to back, calling fn with the zero-based
+// index and the result of each one. It stops at the end of the stream, when fn
+// returns an error, which is returned as is, or when a value can not be decoded.
+func (v *Validator) ValidateMultiStream(r io.Reader, fn func(index int, result *ValidationResult) error) error {
+	dec := json.NewDecoder(r)
+
+	for index := 0; ; index++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("erro ao decodificar valor %d do stream: %w", index, err)
+		}
+
+		result, err := v.ValidateBytes(raw)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(index, result); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package valid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateMultiStream(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	stream := `{"name": "Ana", "email": "ana@x.com"}{"name": "B", "email": "b@x.com"}` + "\n" +
+		`{"name": "Caio", "email": "caio@x.com"}`
+
+	var valid []bool
+	err = validator.ValidateMultiStream(strings.NewReader(stream), func(index int, result *ValidationResult) error {
+		if index != len(valid) {
+			t.Errorf("índice inesperado: %d", index)
+		}
+		valid = append(valid, result.Valid)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if len(valid) != 3 || !valid[0] || valid[1] || !valid[2] {
+		t.Errorf("resultados inesperados: %v", valid)
+	}
+
+	// The callback error stops the stream
+	stop := errors.New("parar")
+	calls := 0
+	err = validator.ValidateMultiStream(strings.NewReader(stream), func(index int, result *ValidationResult) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("esperava parar no primeiro valor com o erro do callback, recebeu %v após %d chamadas", err, calls)
+	}
+
+	// Malformed values end the stream with an error
+	err = validator.ValidateMultiStream(strings.NewReader(`{"name": "Ana", "email": "ana@x.com"}{"name":`), func(index int, result *ValidationResult) error {
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "valor 1") {
+		t.Errorf("esperava erro de decodificação do valor 1, recebeu %v", err)
+	}
+}
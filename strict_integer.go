@@ -0,0 +1,52 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// checkStrictIntegers rejects, for schemas of type integer, numbers written
+// with a fractional part or an exponent. gojsonschema accepts 1.0 and 1e2 as
+// integers because their value is integral; values that are not integral are
+// already reported by it and are skipped here.
+func (v *Validator) checkStrictIntegers(document interface{}) []ValidationError {
+	var errs []ValidationError
+
+	v.walkDocument(document, func(path string, value interface{}, schemas []map[string]interface{}) {
+		number, ok := value.(json.Number)
+		if !ok || isIntegerLiteral(string(number)) {
+			return
+		}
+
+		types := schemaTypes(schemas)
+		if !types["integer"] || types["number"] || !isIntegral(string(number)) {
+			return
+		}
+
+		errs = append(errs, v.completeError(ValidationError{
+			Message:         fmt.Sprintf("tipo inválido: esperado inteiro, recebido número com parte fracionária (%s)", number),
+			redactedMessage: "tipo inválido: esperado inteiro, recebido número com parte fracionária",
+			Value:           number,
+			Constraint:      "invalid_type",
+		}, path))
+	})
+
+	sortErrorsByField(errs)
+	return errs
+}
+
+// isIntegerLiteral reports whether a JSON number token is written as an
+// integer, with no fraction or exponent
+func isIntegerLiteral(token string) bool {
+	if len(token) > 0 && token[0] == '-' {
+		token = token[1:]
+	}
+	return token != "" && isArrayIndex(token)
+}
+
+// isIntegral reports whether a JSON number token has an integral value
+func isIntegral(token string) bool {
+	f, _, err := big.ParseFloat(token, 10, 256, big.ToNearestEven)
+	return err == nil && f.IsInt()
+}
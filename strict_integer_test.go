@@ -0,0 +1,75 @@
+package valid
+
+import "testing"
+
+func TestStrictInteger(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"count": {"type": "integer", "errorMessage": {"type": "count deve ser inteiro"}},
+			"price": {"type": "number"},
+			"items": {"type": "array", "items": {"type": "integer"}}
+		}
+	}`)
+
+	lenient, err := NewFromBytes(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	strict, err := NewFromBytesWithOptions(schema, Options{StrictInteger: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		jsonData     string
+		expectLoose  bool
+		expectStrict bool
+		expectField  string
+	}{
+		{name: "integer", jsonData: `{"count": 1}`, expectLoose: true, expectStrict: true},
+		{name: "integral decimal", jsonData: `{"count": 1.0}`, expectLoose: true, expectField: "count"},
+		{name: "exponent", jsonData: `{"count": 1e2}`, expectLoose: true, expectField: "count"},
+		{name: "negative integral decimal", jsonData: `{"count": -3.00}`, expectLoose: true, expectField: "count"},
+		{name: "number schema", jsonData: `{"price": 1.0}`, expectLoose: true, expectStrict: true},
+		{name: "array items", jsonData: `{"items": [1, 2.0]}`, expectLoose: true, expectField: "items.1"},
+		{name: "fractional value", jsonData: `{"count": 1.5}`, expectField: "count"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := lenient.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectLoose {
+				t.Errorf("sem StrictInteger esperava valid=%v, recebeu %+v", tt.expectLoose, result.Errors)
+			}
+
+			result, err = strict.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectStrict {
+				t.Fatalf("com StrictInteger esperava valid=%v, recebeu %+v", tt.expectStrict, result.Errors)
+			}
+			if tt.expectStrict {
+				return
+			}
+
+			// Values that are not integral are reported once, by gojsonschema
+			if len(result.Errors) != 1 || result.Errors[0].Field != tt.expectField || result.Errors[0].Constraint != "invalid_type" {
+				t.Errorf("esperava um erro invalid_type em '%s', recebeu %+v", tt.expectField, result.Errors)
+			}
+		})
+	}
+
+	result, err := strict.ValidateString(`{"count": 2.0}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Message != "count deve ser inteiro" {
+		t.Errorf("esperava a mensagem personalizada de type, recebeu %+v", result.Errors)
+	}
+}
@@ -0,0 +1,355 @@
+package valid
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// draft7SchemaURI identifies the JSON Schema dialect of generated schemas
+const draft7SchemaURI = "http://json-schema.org/draft-07/schema#"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// SchemaFromStruct generates a Draft 7 JSON Schema from a struct, following the
+// names and options of its json tags. Non-pointer fields without omitempty are
+// required; pointer, slice and map fields also accept null, which encoding/json
+// writes when they are nil. Scalar fields with the string option are strings,
+// as encoding/json writes them. The enum tag lists comma-separated allowed values and
+// the format tag sets the string format:
+//
+//	type Item struct {
+//		ID       string  `json:"id" format:"uuid"`
+//		Category string  `json:"category" enum:"customer,supplier"`
+//		Notes    *string `json:"notes"`
+//	}
+func SchemaFromStruct(v interface{}) ([]byte, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, fmt.Errorf("valor não pode ser nil")
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("esperava struct, recebeu %s", t.Kind())
+	}
+
+	schema := typeSchema(t, make(map[reflect.Type]bool))
+	schema["$schema"] = draft7SchemaURI
+
+	return json.Marshal(schema)
+}
+
+// NewFromStruct creates a validator from the schema generated for a struct
+func NewFromStruct(v interface{}) (*Validator, error) {
+	schemaBytes, err := SchemaFromStruct(v)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromBytes(schemaBytes)
+}
+
+// typeSchema builds the schema of a Go type. visiting guards against recursive types.
+func typeSchema(t reflect.Type, visiting map[reflect.Type]bool) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	// Custom marshalers may produce any shape
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return map[string]interface{}{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		// encoding/json encodes byte slices as base64 strings
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]interface{}{"type": "array", "items": elementSchema(t.Elem(), visiting)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": elementSchema(t.Elem(), visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			return map[string]interface{}{"type": "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		fields := make([]structField, 0, t.NumField())
+		structFields(t, visiting, 0, &fields)
+
+		properties := make(map[string]interface{})
+		required := make([]string, 0)
+		for _, field := range dominantFields(fields) {
+			properties[field.name] = field.schema
+			if field.required {
+				required = append(required, field.name)
+			}
+		}
+
+		schema := map[string]interface{}{
+			"type":       "object",
+			"properties": properties,
+		}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// structField is a JSON property of a struct, with the embedding depth and tag
+// encoding/json uses to pick between fields that share a name
+type structField struct {
+	name     string
+	depth    int
+	tagged   bool
+	required bool
+	schema   map[string]interface{}
+}
+
+// structFields collects the schema of each exported field of t, flattening
+// embedded structs the same way encoding/json does
+func structFields(t reflect.Type, visiting map[reflect.Type]bool, depth int, fields *[]structField) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				// A type that embeds itself contributes no fields beyond the first level
+				if !visiting[embedded] {
+					visiting[embedded] = true
+					structFields(embedded, visiting, depth+1, fields)
+					delete(visiting, embedded)
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+		tagged := name != ""
+		if !tagged {
+			name = field.Name
+		}
+
+		// The string option makes encoding/json write scalars inside a JSON string
+		quoted := hasTagOption(options, "string") && quotable(field.Type)
+
+		fieldSchema := typeSchema(field.Type, visiting)
+		if quoted {
+			fieldSchema = map[string]interface{}{"type": "string"}
+		}
+
+		if format := field.Tag.Get("format"); format != "" {
+			fieldSchema["format"] = format
+		}
+		if enum := field.Tag.Get("enum"); enum != "" {
+			if quoted {
+				fieldSchema["enum"] = quotedEnumValues(field.Type, enum)
+			} else {
+				fieldSchema["enum"] = enumValues(field.Type, enum)
+			}
+		}
+
+		if nilable(field.Type) {
+			allowNull(fieldSchema)
+		}
+
+		omitempty := hasTagOption(options, "omitempty")
+		*fields = append(*fields, structField{
+			name:     name,
+			depth:    depth,
+			tagged:   tagged,
+			required: field.Type.Kind() != reflect.Ptr && !omitempty,
+			schema:   fieldSchema,
+		})
+	}
+}
+
+// dominantFields keeps, for each name, the field encoding/json serializes: the
+// shallowest one, with a tagged field beating untagged ones at the same depth.
+// Names that remain ambiguous are dropped, as encoding/json drops them.
+func dominantFields(fields []structField) []structField {
+	byName := make(map[string][]structField)
+	names := make([]string, 0)
+	for _, field := range fields {
+		if _, ok := byName[field.name]; !ok {
+			names = append(names, field.name)
+		}
+		byName[field.name] = append(byName[field.name], field)
+	}
+
+	dominant := make([]structField, 0, len(names))
+	for _, name := range names {
+		if field, ok := dominantField(byName[name]); ok {
+			dominant = append(dominant, field)
+		}
+	}
+	return dominant
+}
+
+// dominantField picks the field that wins among fields sharing a name
+func dominantField(fields []structField) (structField, bool) {
+	depth := fields[0].depth
+	for _, field := range fields[1:] {
+		if field.depth < depth {
+			depth = field.depth
+		}
+	}
+
+	var shallowest, tagged []structField
+	for _, field := range fields {
+		if field.depth != depth {
+			continue
+		}
+		shallowest = append(shallowest, field)
+		if field.tagged {
+			tagged = append(tagged, field)
+		}
+	}
+
+	if len(shallowest) == 1 {
+		return shallowest[0], true
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return structField{}, false
+}
+
+// hasTagOption reports whether the comma-separated json tag options include option
+func hasTagOption(options, option string) bool {
+	return strings.Contains(","+options+",", ","+option+",")
+}
+
+// quotable reports whether the string tag option applies to t, which encoding/json
+// limits to strings, numbers and booleans, directly or behind one pointer
+func quotable(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// elementSchema builds the schema of the elements of a slice, array or map,
+// which are encoded as null when they are nil
+func elementSchema(t reflect.Type, visiting map[reflect.Type]bool) map[string]interface{} {
+	schema := typeSchema(t, visiting)
+	if nilable(t) {
+		allowNull(schema)
+	}
+	return schema
+}
+
+// nilable reports whether values of t may be nil, which encoding/json encodes as null
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		return true
+	}
+	return false
+}
+
+// allowNull makes a schema accept null besides its type and enum values
+func allowNull(schema map[string]interface{}) {
+	if typeName, ok := schema["type"].(string); ok {
+		schema["type"] = []interface{}{typeName, "null"}
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		schema["enum"] = append(enum, nil)
+	}
+}
+
+// enumValues converts the comma-separated enum tag to values of the field's JSON type
+func enumValues(t reflect.Type, tag string) []interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	parts := strings.Split(tag, ",")
+	values := make([]interface{}, 0, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if number, err := strconv.ParseFloat(part, 64); err == nil {
+				values = append(values, number)
+				continue
+			}
+		case reflect.Bool:
+			if flag, err := strconv.ParseBool(part); err == nil {
+				values = append(values, flag)
+				continue
+			}
+		}
+
+		values = append(values, part)
+	}
+
+	return values
+}
+
+// quotedEnumValues converts the enum tag of a field with the string option to the
+// strings encoding/json writes, quoting string values once more
+func quotedEnumValues(t reflect.Type, tag string) []interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	parts := strings.Split(tag, ",")
+	values := make([]interface{}, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if t.Kind() == reflect.String {
+			part = strconv.Quote(part)
+		}
+		values = append(values, part)
+	}
+	return values
+}
@@ -0,0 +1,311 @@
+package valid
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type structSchemaAddress struct {
+	City string `json:"city"`
+}
+
+type structSchemaBase struct {
+	ID string `json:"id" format:"uuid"`
+}
+
+type structSchemaItem struct {
+	structSchemaBase
+	Category  string               `json:"category" enum:"customer,supplier"`
+	Priority  int                  `json:"priority,omitempty" enum:"1,2,3"`
+	Activated bool                 `json:"activated"`
+	Notes     *string              `json:"notes"`
+	Tags      []string             `json:"tags,omitempty"`
+	Address   *structSchemaAddress `json:"address,omitempty"`
+	CreatedAt time.Time            `json:"createdAt"`
+	Internal  string               `json:"-"`
+	hidden    string
+}
+
+func TestSchemaFromStruct(t *testing.T) {
+	schemaBytes, err := SchemaFromStruct(structSchemaItem{})
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		t.Fatalf("schema gerado não é JSON válido: %v", err)
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	for _, name := range []string{"id", "category", "priority", "activated", "notes", "tags", "address", "createdAt"} {
+		if _, ok := properties[name]; !ok {
+			t.Errorf("esperava propriedade '%s'", name)
+		}
+	}
+	for _, name := range []string{"Internal", "hidden", "structSchemaBase"} {
+		if _, ok := properties[name]; ok {
+			t.Errorf("propriedade '%s' não deveria existir", name)
+		}
+	}
+
+	required := make(map[string]bool)
+	for _, name := range schema["required"].([]interface{}) {
+		required[name.(string)] = true
+	}
+	for name, expected := range map[string]bool{
+		"id": true, "category": true, "activated": true, "createdAt": true,
+		"priority": false, "notes": false, "tags": false, "address": false,
+	} {
+		if required[name] != expected {
+			t.Errorf("campo '%s': esperava required=%v", name, expected)
+		}
+	}
+
+	id := properties["id"].(map[string]interface{})
+	if id["format"] != "uuid" {
+		t.Errorf("esperava format 'uuid' para 'id', recebeu %v", id["format"])
+	}
+}
+
+func TestNewFromStruct(t *testing.T) {
+	validator, err := NewFromStruct(&structSchemaItem{})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		jsonData    string
+		expectValid bool
+	}{
+		{
+			name:        "valid item with null pointer",
+			jsonData:    `{"id": "f57ef656-bb28-4464-89e4-f3815aa7cdc9", "category": "customer", "activated": true, "notes": null, "createdAt": "2024-01-02T15:04:05Z"}`,
+			expectValid: true,
+		},
+		{
+			name:        "valid item without optional fields",
+			jsonData:    `{"id": "f57ef656-bb28-4464-89e4-f3815aa7cdc9", "category": "supplier", "activated": false, "createdAt": "2024-01-02T15:04:05Z", "priority": 2}`,
+			expectValid: true,
+		},
+		{
+			name:        "enum violation",
+			jsonData:    `{"id": "f57ef656-bb28-4464-89e4-f3815aa7cdc9", "category": "partner", "activated": false, "createdAt": "2024-01-02T15:04:05Z"}`,
+			expectValid: false,
+		},
+		{
+			name:        "missing required",
+			jsonData:    `{"category": "customer"}`,
+			expectValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Errorf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+		})
+	}
+
+	if _, err := SchemaFromStruct("texto"); err == nil {
+		t.Error("esperava erro para tipo que não é struct")
+	}
+}
+
+func TestNewFromStructNilValues(t *testing.T) {
+	type order struct {
+		Items    []string          `json:"items"`
+		Labels   map[string]string `json:"labels"`
+		Status   *string           `json:"status" enum:"open,closed"`
+		Discount []*int            `json:"discount"`
+	}
+
+	validator, err := NewFromStruct(order{})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	// The zero value, with nil slices, maps and pointers encoded as null, passes its own schema
+	result, err := validator.ValidateInterface(order{})
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava o valor zero válido, recebeu %+v", result.Errors)
+	}
+
+	closed := "closed"
+	result, err = validator.ValidateInterface(order{Items: []string{"a"}, Status: &closed, Discount: []*int{nil}})
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava pedido válido, recebeu %+v", result.Errors)
+	}
+
+	// The enum still applies to non-null values
+	result, err = validator.ValidateString(`{"items": null, "labels": null, "status": "pending", "discount": null}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Error("esperava violação do enum de status")
+	}
+}
+
+type structSchemaNode struct {
+	*structSchemaNode
+	Name string `json:"name"`
+}
+
+type structSchemaTree struct {
+	*structSchemaLeaf
+	Root string `json:"root"`
+}
+
+type structSchemaLeaf struct {
+	*structSchemaTree
+	Leaf string `json:"leaf"`
+}
+
+func TestSchemaFromStructRecursiveEmbedding(t *testing.T) {
+	for _, value := range []interface{}{structSchemaNode{}, structSchemaTree{}} {
+		schemaBytes, err := SchemaFromStruct(value)
+		if err != nil {
+			t.Fatalf("não esperava erro, mas recebeu: %v", err)
+		}
+
+		var schema map[string]interface{}
+		if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+			t.Fatalf("schema gerado não é JSON válido: %v", err)
+		}
+		if len(schema["properties"].(map[string]interface{})) == 0 {
+			t.Errorf("esperava propriedades no schema de %T, recebeu %s", value, schemaBytes)
+		}
+	}
+
+	validator, err := NewFromStruct(structSchemaTree{})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	result, err := validator.ValidateString(`{"root": "a", "leaf": "b"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava documento válido, recebeu %+v", result.Errors)
+	}
+}
+
+type structSchemaShadowed struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+	Name string
+}
+
+type structSchemaShadowing struct {
+	structSchemaShadowed
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+func TestSchemaFromStructFieldDominance(t *testing.T) {
+	schemaBytes, err := SchemaFromStruct(structSchemaShadowing{})
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		t.Fatalf("schema gerado não é JSON válido: %v", err)
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	id := properties["id"].(map[string]interface{})
+	if id["type"] != "string" {
+		t.Errorf("esperava que o campo externo 'id' prevalecesse, recebeu %v", id)
+	}
+	// The embedded Name is untagged, so its JSON name differs from the outer "name"
+	for _, name := range []string{"id", "kind", "name", "Name"} {
+		if _, ok := properties[name]; !ok {
+			t.Errorf("esperava propriedade '%s'", name)
+		}
+	}
+
+	count := make(map[string]int)
+	for _, name := range schema["required"].([]interface{}) {
+		count[name.(string)]++
+	}
+	if count["id"] != 1 {
+		t.Errorf("esperava 'id' uma única vez em required, recebeu %v", schema["required"])
+	}
+	if count["name"] != 0 {
+		t.Errorf("'name' é omitempty e não deveria ser required, recebeu %v", schema["required"])
+	}
+
+	validator, err := NewFromBytes(schemaBytes)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	encoded, err := json.Marshal(structSchemaShadowing{ID: "a-1"})
+	if err != nil {
+		t.Fatalf("erro ao serializar: %v", err)
+	}
+	result, err := validator.ValidateBytes(encoded)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("esperava %s válido, recebeu %+v", encoded, result.Errors)
+	}
+}
+
+func TestSchemaFromStructStringOption(t *testing.T) {
+	type counter struct {
+		N      int     `json:"n,string"`
+		Active *bool   `json:"active,string"`
+		Level  int     `json:"level,string" enum:"1,2"`
+		Ratio  float64 `json:"ratio"`
+	}
+
+	validator, err := NewFromStruct(counter{})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	active := true
+	encoded, err := json.Marshal(counter{N: 5, Active: &active, Level: 2, Ratio: 0.5})
+	if err != nil {
+		t.Fatalf("erro ao serializar: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		jsonData    string
+		expectValid bool
+	}{
+		{name: "encoded by encoding/json", jsonData: string(encoded), expectValid: true},
+		{name: "quoted number", jsonData: `{"n": "5", "active": null, "level": "1", "ratio": 1}`, expectValid: true},
+		{name: "bare number", jsonData: `{"n": 5, "active": null, "level": "1", "ratio": 1}`, expectValid: false},
+		{name: "enum violation", jsonData: `{"n": "5", "active": null, "level": "3", "ratio": 1}`, expectValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Errorf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+		})
+	}
+}
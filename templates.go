@@ -0,0 +1,58 @@
+package valid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// limitDetails are the gojsonschema error details that hold the limit of a
+// constraint, in the order they are looked up for the {limit} placeholder
+var limitDetails = []string{"min", "max", "multiple", "pattern", "format", "allowed", "expected", "dependency"}
+
+// interpolateMessage replaces the placeholders of a custom message with the
+// details of the violation:
+//
+//   - {field} the dotted path of the field; for required, of the missing property
+//   - {value} the offending value
+//   - {limit} the limit of the constraint: the bound of minLength, maxLength,
+//     minimum, maximum, exclusiveMinimum, exclusiveMaximum, minItems, maxItems,
+//     minProperties and maxProperties, the divisor of multipleOf, the pattern,
+//     the format, the allowed values of enum and const, the expected type of
+//     type and the property required by dependencies
+//
+// With redact {value} is replaced by "***", as done for the RedactValues option
+// and for the message ValidationResult.RedactValues swaps in.
+func (v *Validator) interpolateMessage(message, field string, err gojsonschema.ResultError, redact bool) string {
+	if !strings.Contains(message, "{") {
+		return message
+	}
+
+	details := err.Details()
+	if property, ok := details["property"].(string); ok && err.Type() == "required" {
+		field = joinFieldPath(field, property)
+	}
+
+	limit := ""
+	for _, key := range limitDetails {
+		if detail, ok := details[key]; ok {
+			limit = fmt.Sprint(detail)
+			break
+		}
+	}
+
+	value := ""
+	switch {
+	case redact:
+		value = "***"
+	case err.Value() != nil:
+		value = fmt.Sprint(err.Value())
+	}
+
+	return strings.NewReplacer(
+		"{field}", field,
+		"{value}", value,
+		"{limit}", limit,
+	).Replace(message)
+}
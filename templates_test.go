@@ -0,0 +1,79 @@
+package valid
+
+import "testing"
+
+const templateSchema = `{
+	"type": "object",
+	"properties": {
+		"name": {
+			"type": "string",
+			"minLength": 3,
+			"errorMessage": {"minLength": "{field} deve ter ao menos {limit} caracteres (recebido: {value})"}
+		},
+		"age": {
+			"type": "integer",
+			"maximum": 120,
+			"errorMessage": {"maximum": "{field} deve ser no máximo {limit}, recebido {value}", "type": "{field} deve ser do tipo {limit}"}
+		},
+		"status": {
+			"enum": ["active", "inactive"],
+			"errorMessage": {"enum": "{value} não está entre {limit}"}
+		},
+		"zip": {"type": "string", "pattern": "^[0-9]{5}$", "errorMessage": {"pattern": "{field} deve seguir {limit}"}},
+		"email": {"type": "string", "errorMessage": {"required": "{field} é obrigatório"}},
+		"plain": {"type": "string", "maxLength": 2, "errorMessage": {"maxLength": "sem placeholders"}}
+	},
+	"required": ["email"]
+}`
+
+func TestMessageTemplates(t *testing.T) {
+	validator, err := NewFromString(templateSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		jsonData      string
+		expectField   string
+		expectMessage string
+	}{
+		{name: "minLength", jsonData: `{"email": "a", "name": "Al"}`, expectField: "name", expectMessage: "name deve ter ao menos 3 caracteres (recebido: Al)"},
+		{name: "maximum", jsonData: `{"email": "a", "age": 130}`, expectField: "age", expectMessage: "age deve ser no máximo 120, recebido 130"},
+		{name: "type", jsonData: `{"email": "a", "age": "x"}`, expectField: "age", expectMessage: "age deve ser do tipo integer"},
+		{name: "enum", jsonData: `{"email": "a", "status": "gone"}`, expectField: "status", expectMessage: `gone não está entre "active", "inactive"`},
+		{name: "pattern", jsonData: `{"email": "a", "zip": "abc"}`, expectField: "zip", expectMessage: "zip deve seguir ^[0-9]{5}$"},
+		{name: "required", jsonData: `{}`, expectField: "", expectMessage: "email é obrigatório"},
+		{name: "static message", jsonData: `{"email": "a", "plain": "abc"}`, expectField: "plain", expectMessage: "sem placeholders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateString(tt.jsonData)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if len(result.Errors) != 1 {
+				t.Fatalf("esperava 1 erro, recebeu %+v", result.Errors)
+			}
+			if result.Errors[0].Field != tt.expectField || result.Errors[0].Message != tt.expectMessage {
+				t.Errorf("esperava '%s' em '%s', recebeu '%s' em '%s'", tt.expectMessage, tt.expectField, result.Errors[0].Message, result.Errors[0].Field)
+			}
+		})
+	}
+}
+
+func TestMessageTemplatesRedacted(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(templateSchema), Options{RedactValues: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"email": "a", "name": "Al"}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Message != "name deve ter ao menos 3 caracteres (recebido: ***)" {
+		t.Errorf("esperava o valor omitido da mensagem, recebeu %+v", result.Errors)
+	}
+}
@@ -0,0 +1,56 @@
+package valid
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// validateWithTimeout validates the request within config.Timeout, covering
+// reading the body and validating it. gojsonschema can not be interrupted, so
+// on timeout the validation is left to finish in the background and its
+// outcome discarded.
+func (v *Validator) validateWithTimeout(r *http.Request, config MiddlewareConfig) (*ValidationResult, error) {
+	if config.Timeout <= 0 {
+		return v.validateMiddlewareRequest(context.Background(), r, config)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), config.Timeout)
+	defer cancel()
+
+	type outcome struct {
+		result *ValidationResult
+		err    error
+	}
+	done := make(chan outcome, 1)
+
+	go func() {
+		result, err := v.validateMiddlewareRequest(ctx, r, config)
+		done <- outcome{result: result, err: err}
+	}()
+
+	select {
+	case o := <-done:
+		return o.result, o.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// validationTimedOut writes the response for a validation over Timeout and
+// reports whether err is that case
+func validationTimedOut(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	http.Error(w, "Tempo limite de validação excedido", http.StatusServiceUnavailable)
+	return true
+}
+
+// requestCanceled reports whether validation failed because the client went
+// away, canceling the request context. Nobody is left to read a response, so
+// none is written and the failure is not reported as a server error.
+func requestCanceled(r *http.Request, err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(r.Context().Err(), context.Canceled)
+}
@@ -0,0 +1,99 @@
+package valid
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// slowReader delays every read, simulating a client that trickles its body
+type slowReader struct {
+	reader io.Reader
+	delay  time.Duration
+}
+
+func (s *slowReader) Read(p []byte) (int, error) {
+	time.Sleep(s.delay)
+	return s.reader.Read(p)
+}
+
+func TestMiddlewareTimeout(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handlerCalled := false
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		Timeout: 20 * time.Millisecond,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	body := `{"name": "Ana", "email": "ana@x.com"}`
+
+	// Validation within the timeout reaches the handler
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if !handlerCalled || w.Code != http.StatusOK {
+		t.Errorf("esperava o handler chamado com status 200, recebeu chamado=%v status=%d", handlerCalled, w.Code)
+	}
+
+	// A body slower than the timeout is answered with 503
+	req = httptest.NewRequest("POST", "/test", &slowReader{reader: strings.NewReader(body), delay: 200 * time.Millisecond})
+	w = httptest.NewRecorder()
+	handlerCalled = false
+
+	start := time.Now()
+	middleware(w, req)
+
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("esperava resposta no tempo limite, levou %v", elapsed)
+	}
+	if handlerCalled {
+		t.Error("handler não deveria ter sido chamado após o tempo limite")
+	}
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("esperava status 503, recebeu %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "Tempo limite de validação excedido") {
+		t.Errorf("mensagem inesperada: %s", w.Body.String())
+	}
+}
+
+func TestMiddlewareTimeoutClientCanceled(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handlerCalled := false
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		Timeout: time.Second,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	// The client goes away while the body is still being read
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	body := &slowReader{reader: strings.NewReader(`{"name": "Ana", "email": "ana@x.com"}`), delay: 200 * time.Millisecond}
+	req := httptest.NewRequest("POST", "/test", body).WithContext(ctx)
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if handlerCalled {
+		t.Error("handler não deveria ter sido chamado após o cancelamento")
+	}
+	if w.Code == http.StatusInternalServerError || w.Body.Len() > 0 {
+		t.Errorf("não esperava resposta após o cancelamento, recebeu status %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -1,6 +1,10 @@
 package utils
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"time"
+)
 
 // GetEnvOrDefault retrieves an environment variable or uses a default value
 func GetEnvOrDefault(key, defaultValue string) string {
@@ -9,3 +13,45 @@ func GetEnvOrDefault(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// GetEnvIntOrDefault retrieves an environment variable as an int or uses a default value
+func GetEnvIntOrDefault(key string, defaultValue int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
+}
+
+// GetEnvBoolOrDefault retrieves an environment variable as a bool or uses a default value
+func GetEnvBoolOrDefault(key string, defaultValue bool) bool {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
+}
+
+// GetEnvDurationOrDefault retrieves an environment variable as a time.Duration or uses a default value
+func GetEnvDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return defaultValue
+	}
+	return parsed
+}
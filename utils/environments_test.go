@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetEnvOrDefault(t *testing.T) {
+	t.Setenv("TEST_STRING", "valor")
+
+	if got := GetEnvOrDefault("TEST_STRING", "padrao"); got != "valor" {
+		t.Errorf("esperava 'valor', recebeu '%s'", got)
+	}
+	if got := GetEnvOrDefault("TEST_STRING_INEXISTENTE", "padrao"); got != "padrao" {
+		t.Errorf("esperava 'padrao', recebeu '%s'", got)
+	}
+}
+
+func TestGetEnvIntOrDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		set      bool
+		expected int
+	}{
+		{name: "valid int", value: "1024", set: true, expected: 1024},
+		{name: "negative int", value: "-5", set: true, expected: -5},
+		{name: "malformed value", value: "dez", set: true, expected: 42},
+		{name: "float value", value: "1.5", set: true, expected: 42},
+		{name: "empty value", value: "", set: true, expected: 42},
+		{name: "unset", set: false, expected: 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TEST_INT", tt.value)
+			}
+			if got := GetEnvIntOrDefault("TEST_INT", 42); got != tt.expected {
+				t.Errorf("esperava %d, recebeu %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetEnvBoolOrDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		set      bool
+		expected bool
+	}{
+		{name: "true", value: "true", set: true, expected: true},
+		{name: "numeric true", value: "1", set: true, expected: true},
+		{name: "false", value: "false", set: true, expected: false},
+		{name: "malformed value", value: "sim", set: true, expected: true},
+		{name: "empty value", value: "", set: true, expected: true},
+		{name: "unset", set: false, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TEST_BOOL", tt.value)
+			}
+			if got := GetEnvBoolOrDefault("TEST_BOOL", true); got != tt.expected {
+				t.Errorf("esperava %v, recebeu %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetEnvDurationOrDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		set      bool
+		expected time.Duration
+	}{
+		{name: "valid duration", value: "2s", set: true, expected: 2 * time.Second},
+		{name: "composite duration", value: "1m30s", set: true, expected: 90 * time.Second},
+		{name: "missing unit", value: "30", set: true, expected: time.Minute},
+		{name: "malformed value", value: "um minuto", set: true, expected: time.Minute},
+		{name: "unset", set: false, expected: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.set {
+				t.Setenv("TEST_DURATION", tt.value)
+			}
+			if got := GetEnvDurationOrDefault("TEST_DURATION", time.Minute); got != tt.expected {
+				t.Errorf("esperava %v, recebeu %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -2,13 +2,22 @@
 package valid
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"math/big"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/raywall/json-schema-validation/utils"
 	"github.com/xeipuuv/gojsonschema"
 )
 
@@ -18,25 +27,218 @@ type ValidationError struct {
 	Message    string      `json:"message"`
 	Value      interface{} `json:"value,omitempty"`
 	Constraint string      `json:"constraint,omitempty"`
+	Code       string      `json:"code,omitempty"`
+	Severity   Severity    `json:"severity,omitempty"`
 	Context    string      `json:"context,omitempty"`
+	// HumanPath is the field path for display to end users, e.g. "address → zipCode"
+	HumanPath string `json:"humanPath,omitempty"`
+	DocsURL   string `json:"docsUrl,omitempty"`
+	// Fields lists the fields involved in a cross-field violation
+	Fields []string `json:"fields,omitempty"`
+	// Details holds the parameters gojsonschema reports for the violation, such
+	// as min, max, pattern or property, without field and context
+	Details map[string]interface{} `json:"details,omitempty"`
+
+	// redactedMessage is Message without the offending value, set when Message
+	// quotes it, and used in its place by RedactValues
+	redactedMessage string
 }
 
 // ValidationResult represents the result of a validation
 type ValidationResult struct {
 	Valid  bool              `json:"valid"`
 	Errors []ValidationError `json:"errors,omitempty"`
+	// Raw holds the validated bytes when the CaptureRaw option is enabled
+	Raw []byte `json:"-"`
+	// Duration is the time spent validating when the MeasureTiming option is enabled
+	Duration time.Duration `json:"-"`
+	// Normalized holds the document as validated when the Normalize option is enabled
+	Normalized []byte `json:"-"`
+}
+
+// RedactValues removes the offending values from the errors, including the
+// messages that quote them, so they are not echoed back in responses or logs
+func (vr *ValidationResult) RedactValues() {
+	for i := range vr.Errors {
+		vr.Errors[i].Value = nil
+		if vr.Errors[i].redactedMessage != "" {
+			vr.Errors[i].Message = vr.Errors[i].redactedMessage
+			vr.Errors[i].redactedMessage = ""
+		}
+	}
+}
+
+// FirstError returns the first error-level violation, or nil when the result is valid
+func (vr *ValidationResult) FirstError() *ValidationError {
+	if vr.Valid {
+		return nil
+	}
+
+	for i := range vr.Errors {
+		if vr.Errors[i].Severity != SeverityWarning {
+			return &vr.Errors[i]
+		}
+	}
+	return nil
+}
+
+// Summary describes the error-level violations in a single message naming the
+// invalid fields, such as "3 campos inválidos: name, email, age"
+func (vr *ValidationResult) Summary() string {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, validationErr := range vr.Errors {
+		if validationErr.Severity == SeverityWarning || seen[validationErr.Field] {
+			continue
+		}
+		seen[validationErr.Field] = true
+		fields = append(fields, displayField(validationErr.Field))
+	}
+
+	switch len(fields) {
+	case 0:
+		return "Dados de entrada válidos"
+	case 1:
+		return fmt.Sprintf("1 campo inválido: %s", fields[0])
+	default:
+		return fmt.Sprintf("%d campos inválidos: %s", len(fields), strings.Join(fields, ", "))
+	}
+}
+
+// HasFieldError reports whether any violation was reported for field
+func (vr *ValidationResult) HasFieldError(field string) bool {
+	for _, validationErr := range vr.Errors {
+		if validationErr.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// RootFieldKey is the key under which ByField groups the errors of the root document
+const RootFieldKey = "_root"
+
+// ByField groups the messages of the result by dotted field, as expected by
+// form libraries. Errors of the root document are grouped under RootFieldKey.
+func (vr *ValidationResult) ByField() map[string][]string {
+	grouped := make(map[string][]string)
+	for _, validationErr := range vr.Errors {
+		field := validationErr.Field
+		if field == "" {
+			field = RootFieldKey
+		}
+		grouped[field] = append(grouped[field], validationErr.Message)
+	}
+	return grouped
+}
+
+// MissingRequired returns the dotted paths of the required fields missing from
+// the document, such as "name" or "address.city", in the order they were reported
+func (vr *ValidationResult) MissingRequired() []string {
+	var missing []string
+	seen := make(map[string]bool)
+	for _, validationErr := range vr.Errors {
+		if validationErr.Constraint != "required" {
+			continue
+		}
+
+		// gojsonschema reports the error on the object missing the property
+		field := validationErr.Field
+		if property, ok := validationErr.Details["property"].(string); ok {
+			if field == "" {
+				field = property
+			} else {
+				field += "." + property
+			}
+		}
+
+		if field != "" && !seen[field] {
+			seen[field] = true
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
+
+// Merge adds the errors of other to the result, which stays valid only when
+// both are. A nil other leaves the result unchanged.
+func (vr *ValidationResult) Merge(other *ValidationResult) {
+	if other == nil {
+		return
+	}
+
+	vr.Errors = append(vr.Errors, other.Errors...)
+	vr.Valid = vr.Valid && other.Valid
+}
+
+// MergeResults combines the results of separate checks of the same document
+// into a new result, valid only when all of them are. Nil results are skipped.
+func MergeResults(results ...*ValidationResult) *ValidationResult {
+	merged := &ValidationResult{Valid: true}
+	for _, result := range results {
+		merged.Merge(result)
+	}
+	return merged
 }
 
 // ErrorResponse represents the standard http error response
 type ErrorResponse struct {
-	Error   string            `json:"error"`
-	Details []ValidationError `json:"details,omitempty"`
+	Error     string            `json:"error"`
+	Details   []ValidationError `json:"details,omitempty"`
+	RequestID string            `json:"requestId,omitempty"`
+	Meta      *ErrorMeta        `json:"meta,omitempty"`
+}
+
+// ErrorMeta identifies when and by which schema a request was rejected, to
+// correlate a client's failing request with the schema in use at the time
+type ErrorMeta struct {
+	// Timestamp time of the validation in RFC 3339
+	Timestamp string `json:"timestamp"`
+	// SchemaVersion $id of the schema, or its SchemaHash when it has none
+	SchemaVersion string `json:"schemaVersion"`
+}
+
+// NewErrorResponse builds the standard error body for a validation result, so
+// custom handlers produce the same structure as the default one
+func NewErrorResponse(result *ValidationResult, message string) ErrorResponse {
+	response := ErrorResponse{Error: message}
+	if result != nil {
+		response.Details = result.Errors
+	}
+	return response
+}
+
+// WriteJSON writes the error response as JSON with the given HTTP status
+func (er ErrorResponse) WriteJSON(w http.ResponseWriter, status int) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(er)
 }
 
 // Validator encapsulates the Json Schema validator
 type Validator struct {
-	schema       gojsonschema.JSONLoader
+	schema       *gojsonschema.Schema
+	schemaBytes  []byte
+	schemaObj    map[string]interface{} // Schema decodificado, somente leitura
+	schemaETag   string
+	schemaHash   string
 	customErrors map[string]map[string]string // Mapa de mensagens de erro personalizadas
+	customCodes  map[string]map[string]string // Mapa de códigos de erro personalizados
+	severities   map[string]map[string]Severity
+	dependencies []dependencyRule
+	// schemaKeywords every keyword used by the schema, to find its custom keywords
+	schemaKeywords map[string]bool
+	crossRules     *crossFieldRules // Rules added with AddCrossFieldRule, shared with reloaded validators
+	schemaURI      string           // Location of the schema file, used as base for relative $refs
+	store          *SchemaStore     // Named schemas referenced by $ref, set by NewFromBytesWithStore
+	derived        derivedCache
+	results        *resultCache // Results cached when Options.ResultCacheSize is set
+	pool           ValidatorPool
+	opts           Options
+
+	mu           sync.RWMutex // Guards current and errorHandler
+	current      *Validator   // Validator built by the last Reload, used by every operation
+	errorHandler func(w http.ResponseWriter, r *http.Request, result *ValidationResult)
 }
 
 // New creates a new validator from a Schema file
@@ -52,7 +254,29 @@ func New(schemaPath string) (*Validator, error) {
 		return nil, fmt.Errorf("erro ao ler arquivo de schema '%s': %w", schemaPath, err)
 	}
 
-	return NewFromBytes(schemaBytes)
+	// Relative $refs resolve against the directory of the schema file
+	schemaURI, err := fileURI(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newValidator(schemaBytes, Options{}, schemaURI, nil)
+}
+
+// NewFromEnv creates a validator from an environment variable holding either
+// a schema file path or an inline JSON Schema
+func NewFromEnv(envKey string) (*Validator, error) {
+	value := strings.TrimSpace(utils.GetEnvOrDefault(envKey, ""))
+	if value == "" {
+		return nil, fmt.Errorf("variável de ambiente '%s' não definida ou vazia", envKey)
+	}
+
+	// Inline schemas are JSON objects, anything else is treated as a file path
+	if strings.HasPrefix(value, "{") {
+		return NewFromString(value)
+	}
+
+	return New(value)
 }
 
 // NewFromString creates a validator from a string JSON Schema
@@ -65,24 +289,82 @@ func NewFromString(schemaJSON string) (*Validator, error) {
 
 // NewFromBytes creates a validator from bytes of a JSON Schema
 func NewFromBytes(schemaBytes []byte) (*Validator, error) {
+	return NewFromBytesWithOptions(schemaBytes, Options{})
+}
+
+// NewFromBytesWithOptions creates a validator from bytes of a JSON Schema with custom settings
+func NewFromBytesWithOptions(schemaBytes []byte, opts Options) (*Validator, error) {
+	return newValidator(schemaBytes, opts, "", nil)
+}
+
+// newValidator creates a validator from bytes of a JSON Schema. When schemaURI is
+// set relative $refs resolve against that location, when store is set they
+// resolve to its schemas.
+func newValidator(schemaBytes []byte, opts Options, schemaURI string, store *SchemaStore) (*Validator, error) {
+	if !opts.RejectBOM {
+		schemaBytes = stripBOM(schemaBytes)
+	}
 	if len(schemaBytes) == 0 {
 		return nil, fmt.Errorf("schema bytes não podem estar vazios")
 	}
 
+	// The validator keeps its own copy, so callers may reuse their buffer
+	schemaBytes = append(make([]byte, 0, len(schemaBytes)), schemaBytes...)
+
 	// Parse the schema to extract custom error messages
 	var schemaObj map[string]interface{}
 	if err := json.Unmarshal(schemaBytes, &schemaObj); err != nil {
 		return nil, fmt.Errorf("schema JSON inválido: %w", err)
 	}
 
+	if err := checkPatterns(schemaObj); err != nil {
+		return nil, err
+	}
+
+	if opts.StrictFormats {
+		if err := checkFormats(schemaObj, opts.DisabledFormats); err != nil {
+			return nil, err
+		}
+	}
+
 	// Extract custom error messages from schema
 	customErrors := extractErrorMessages(schemaObj)
 
-	schema := gojsonschema.NewBytesLoader(schemaBytes)
+	hash, err := normalizedHash(schemaBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Disabled formats are removed from the compiled schema only
+	compiledBytes := schemaBytes
+	if len(opts.DisabledFormats) > 0 {
+		if compiledBytes, err = withoutFormats(schemaBytes, opts.DisabledFormats); err != nil {
+			return nil, err
+		}
+	}
+
+	// Compiles the schema once so validations do not pay for parsing it again
+	schema, err := compileSchema(compiledBytes, schemaURI, store)
+	if err != nil {
+		return nil, fmt.Errorf("schema inválido: %w", err)
+	}
 
 	return &Validator{
-		schema:       schema,
-		customErrors: customErrors,
+		schema:         schema,
+		schemaBytes:    schemaBytes,
+		schemaURI:      schemaURI,
+		store:          store,
+		schemaObj:      schemaObj,
+		schemaETag:     schemaETag(schemaBytes),
+		schemaHash:     hash,
+		customErrors:   customErrors,
+		customCodes:    extractErrorCodes(schemaObj),
+		severities:     extractSeverities(schemaObj),
+		dependencies:   extractDependencies(schemaObj),
+		schemaKeywords: schemaKeywordSet(schemaObj),
+		crossRules:     &crossFieldRules{},
+		results:        newResultCache(opts.ResultCacheSize),
+		opts:           opts,
 	}, nil
 }
 
@@ -90,23 +372,23 @@ func NewFromBytes(schemaBytes []byte) (*Validator, error) {
 func extractErrorMessages(schema map[string]interface{}) map[string]map[string]string {
 	errorMessages := make(map[string]map[string]string)
 
-	if items, ok := schema["items"].(map[string]interface{}); ok {
-		if props, ok := items["properties"].(map[string]interface{}); ok {
-			for field, prop := range props {
-				if propMap, ok := prop.(map[string]interface{}); ok {
-					if errMsg, ok := propMap["errorMessage"].(map[string]interface{}); ok {
-						fieldErrors := make(map[string]string)
-						for key, msg := range errMsg {
-							if msgStr, ok := msg.(string); ok {
-								fieldErrors[key] = msgStr
-							}
+	for _, props := range schemaPropertyMaps(schema) {
+		for field, prop := range props {
+			if propMap, ok := prop.(map[string]interface{}); ok {
+				if errMsg, ok := propMap["errorMessage"].(map[string]interface{}); ok {
+					fieldErrors := make(map[string]string)
+					for key, msg := range errMsg {
+						if msgStr, ok := msg.(string); ok {
+							fieldErrors[key] = msgStr
 						}
-						errorMessages[field] = fieldErrors
 					}
+					errorMessages[field] = fieldErrors
 				}
 			}
 		}
+	}
 
+	if items, ok := schema["items"].(map[string]interface{}); ok {
 		// Extract required field messages
 		if errMsg, ok := items["errorMessage"].(map[string]interface{}); ok {
 			if requiredMsgs, ok := errMsg["required"].(map[string]interface{}); ok {
@@ -127,6 +409,57 @@ func extractErrorMessages(schema map[string]interface{}) map[string]map[string]s
 
 // ValidateRequest validates an HTTP request against Schema
 func (v *Validator) ValidateRequest(r *http.Request) (*ValidationResult, error) {
+	body, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.ValidateBytes(body)
+}
+
+// compileSchema compiles the schema bytes. When schemaURI is set the document is
+// registered under that URI, so relative $refs resolve against its location.
+// The schemas of store are registered alongside it.
+func compileSchema(schemaBytes []byte, schemaURI string, store *SchemaStore) (*gojsonschema.Schema, error) {
+	if schemaURI == "" && store == nil {
+		return gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schemaBytes))
+	}
+
+	loader := gojsonschema.NewSchemaLoader()
+	if store != nil {
+		if err := store.addTo(loader); err != nil {
+			return nil, err
+		}
+		if schemaURI == "" {
+			// Places the schema next to the stored ones so their names resolve
+			schemaURI = storeBaseURI
+		}
+	}
+
+	if err := loader.AddSchema(schemaURI, gojsonschema.NewBytesLoader(schemaBytes)); err != nil {
+		return nil, err
+	}
+	return loader.Compile(gojsonschema.NewReferenceLoader(schemaURI))
+}
+
+// fileURI returns the file:// URI of a local path
+func fileURI(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("erro ao resolver caminho do schema '%s': %w", path, err)
+	}
+
+	slashPath := filepath.ToSlash(absPath)
+	if !strings.HasPrefix(slashPath, "/") {
+		// Windows paths such as C:/schemas
+		slashPath = "/" + slashPath
+	}
+
+	return (&url.URL{Scheme: "file", Path: slashPath}).String(), nil
+}
+
+// readRequestBody reads the request body and rewinds it so it can be read again
+func readRequestBody(r *http.Request) ([]byte, error) {
 	if r == nil {
 		return nil, fmt.Errorf("requisição não pode ser nil")
 	}
@@ -143,40 +476,73 @@ func (v *Validator) ValidateRequest(r *http.Request) (*ValidationResult, error)
 	// Allows to reuse the requisition body
 	r.Body = io.NopCloser(strings.NewReader(string(body)))
 
-	return v.ValidateBytes(body)
+	return body, nil
 }
 
 // ValidateBytes validates JSON bytes against schema
 func (v *Validator) ValidateBytes(jsonData []byte) (*ValidationResult, error) {
-	if len(jsonData) == 0 {
-		return nil, fmt.Errorf("dados JSON não podem estar vazios")
-	}
-
-	// Validates if it is valid JSON before validating the schema
-	var jsonObj interface{}
-	if err := json.Unmarshal(jsonData, &jsonObj); err != nil {
-		return &ValidationResult{
-			Valid: false,
-			Errors: []ValidationError{
-				{
-					Field:      "root",
-					Message:    fmt.Sprintf("JSON inválido: %s", err.Error()),
-					Constraint: "format",
-				},
-			},
-		}, nil
+	return v.ValidateBytesContext(context.Background(), jsonData)
+}
+
+// runSchema validates a loaded document against the compiled schema, turning
+// panics raised inside gojsonschema or custom format checkers into errors
+func (v *Validator) runSchema(document gojsonschema.JSONLoader) (result *gojsonschema.Result, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result, err = nil, fmt.Errorf("erro durante validação do schema: panic: %v", recovered)
+		}
+	}()
+
+	result, err = v.schema.Validate(document)
+	if err != nil {
+		return nil, fmt.Errorf("erro durante validação do schema: %w", err)
+	}
+	return result, nil
+}
+
+// validateDocument checks that the bytes are well-formed JSON and validates them against the schema
+func (v *Validator) validateDocument(jsonData []byte) (*ValidationResult, error) {
+	// Validates if it is valid JSON before validating the schema. json.Valid does
+	// not allocate, the document is only decoded again to describe the syntax error
+	if !v.opts.SkipJSONPrecheck && !json.Valid(jsonData) {
+		var jsonObj interface{}
+		return malformedJSON(json.Unmarshal(jsonData, &jsonObj)), nil
 	}
 
 	document := gojsonschema.NewBytesLoader(jsonData)
 
-	result, err := gojsonschema.Validate(v.schema, document)
+	result, err := v.runSchema(document)
 	if err != nil {
-		return nil, fmt.Errorf("erro durante validação do schema: %w", err)
+		if v.opts.SkipJSONPrecheck && isSyntaxError(err) {
+			return malformedJSON(errors.Unwrap(err)), nil
+		}
+		return nil, err
 	}
 
 	return v.buildValidationResult(result), nil
 }
 
+// malformedJSON returns the result reported for a document that is not valid JSON
+func malformedJSON(err error) *ValidationResult {
+	return &ValidationResult{
+		Valid: false,
+		Errors: []ValidationError{
+			{
+				Field:      "root",
+				Message:    fmt.Sprintf("JSON inválido: %s", err.Error()),
+				Constraint: "format",
+				Code:       codeInvalidJSON,
+			},
+		},
+	}
+}
+
+// isSyntaxError reports whether gojsonschema failed to decode the document
+func isSyntaxError(err error) bool {
+	var syntaxErr *json.SyntaxError
+	return errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
 // buildValidationResult builds the validation result with custom error messages
 func (v *Validator) buildValidationResult(result *gojsonschema.Result) *ValidationResult {
 	validationResult := &ValidationResult{
@@ -185,6 +551,7 @@ func (v *Validator) buildValidationResult(result *gojsonschema.Result) *Validati
 
 	if !result.Valid() {
 		validationResult.Errors = make([]ValidationError, 0, len(result.Errors()))
+		hasPropertyNames := false
 
 		for _, err := range result.Errors() {
 			field := strings.TrimPrefix(err.Field(), "(root).")
@@ -193,46 +560,147 @@ func (v *Validator) buildValidationResult(result *gojsonschema.Result) *Validati
 			}
 
 			// Try to get custom error message
-			message := v.getCustomErrorMessage(field, err)
+			message, redacted := v.getCustomErrorMessage(field, err)
 
 			validationErr := ValidationError{
-				Field:      field,
-				Message:    message,
-				Constraint: err.Type(),
-				Context:    err.Context().String(),
+				Field:           field,
+				Message:         message,
+				Constraint:      err.Type(),
+				Code:            v.errorCode(field, err.Type()),
+				Context:         err.Context().String(),
+				Details:         errorDetails(err),
+				redactedMessage: redacted,
 			}
 
 			if err.Value() != nil {
 				validationErr.Value = err.Value()
 			}
+			if count, ok := propertyCount(err); ok {
+				validationErr.Value = count
+			}
+			if name, ok := propertyName(err); ok {
+				validationErr.Value = name
+				hasPropertyNames = true
+			}
+			if expected, ok := constExpected(err); ok {
+				validationErr.Details["expected"] = expected
+			}
 
 			validationResult.Errors = append(validationResult.Errors, validationErr)
 		}
+
+		if hasPropertyNames {
+			validationResult.Errors = foldPropertyNameErrors(validationResult.Errors)
+		}
 	}
 
 	return validationResult
 }
 
-// getCustomErrorMessage tries to find a custom error message for the validation error
-func (v *Validator) getCustomErrorMessage(field string, err gojsonschema.ResultError) string {
-	// Split field path for nested properties
-	fieldPath := strings.Split(field, ".")
-	baseField := fieldPath[0]
+// errorDetails returns the details of a gojsonschema error, leaving out field
+// and context, already reported by ValidationError. Numeric limits are
+// converted to json.Number so they are encoded as JSON numbers.
+func errorDetails(err gojsonschema.ResultError) map[string]interface{} {
+	details := make(map[string]interface{}, len(err.Details()))
+	for key, value := range err.Details() {
+		if key == "field" || key == "context" {
+			continue
+		}
+		if number, ok := value.(*big.Float); ok {
+			value = json.Number(number.Text('g', -1))
+		}
+		details[key] = value
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return details
+}
+
+// schemaFieldKey returns the property name under which the settings of a field
+// are extracted from the schema, skipping the index of the items of a root array
+func (v *Validator) schemaFieldKey(field string) string {
+	segments := strings.Split(field, ".")
+	if _, isArray := v.schemaObj["items"]; isArray && len(segments) > 1 && isArrayIndex(segments[0]) {
+		return segments[1]
+	}
+	return segments[0]
+}
+
+// isArrayIndex reports whether a field path segment is an array index
+func isArrayIndex(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// getCustomErrorMessage tries to find a custom error message for the validation
+// error. When the message quotes the offending value, it also returns the
+// message with the value redacted, or "" otherwise.
+func (v *Validator) getCustomErrorMessage(field string, err gojsonschema.ResultError) (string, string) {
+	if err.Type() == "missing_dependency" {
+		return v.dependencyMessage(err), ""
+	}
+
+	if template, ok := v.customMessage(field, err); ok {
+		message := v.interpolateMessage(template, field, err, v.opts.RedactValues)
+		if redacted := v.interpolateMessage(template, field, err, true); redacted != message {
+			return message, redacted
+		}
+		return message, ""
+	}
+
+	if msg, ok := propertyCountMessage(err); ok {
+		return msg, ""
+	}
+	if msg, ok := propertyNameMessage(err); ok {
+		return msg, ""
+	}
+	if msg, ok := constMessage(err); ok {
+		return msg, ""
+	}
+
+	// Fallback to default description
+	return err.Description(), ""
+}
+
+// customMessage returns the message template set in the schema for the validation error
+func (v *Validator) customMessage(field string, err gojsonschema.ResultError) (string, bool) {
+	baseField := v.schemaFieldKey(field)
+
+	// Required errors are reported on the object, the message is set on the
+	// missing property of a root object or of the items of a root array
+	if err.Type() == "required" && (baseField == "" || isArrayIndex(baseField)) {
+		if property, ok := err.Details()["property"].(string); ok {
+			if msg, ok := v.customErrors[property]["required"]; ok {
+				return msg, true
+			}
+		}
+	}
 
 	if fieldMessages, ok := v.customErrors[baseField]; ok {
 		// Check for specific constraint message
 		if msg, ok := fieldMessages[err.Type()]; ok {
-			return msg
+			return msg, true
+		}
+		if msg, ok := fieldMessages[constraintKeyword(err.Type())]; ok {
+			return msg, true
 		}
 
 		// Check for generic message
 		if msg, ok := fieldMessages["_"]; ok {
-			return msg
+			return msg, true
 		}
 	}
 
-	// Fallback to default description
-	return err.Description()
+	return "", false
 }
 
 // ValidateString validates a JSON string against the schema
@@ -240,9 +708,24 @@ func (v *Validator) ValidateString(jsonString string) (*ValidationResult, error)
 	return v.ValidateBytes([]byte(jsonString))
 }
 
-// ValidateInterface validates an interface{} against the schema
+// ValidateInterface validates an interface{} against the schema.
+//
+// The value is marshaled with json.Marshal, so the schema sees what
+// encoding/json produces rather than the Go value itself: fields tagged
+// omitempty disappear when they hold a zero value, which hides them from
+// required and from constraints such as minimum 0; fields tagged "-" and
+// unexported fields are never seen; nil slices and maps become null instead
+// of an empty array or object; and a custom MarshalJSON may emit any shape.
+// Use ValidateInterfaceWith to supply a marshaler suited for validation.
 func (v *Validator) ValidateInterface(data interface{}) (*ValidationResult, error) {
-	jsonBytes, err := json.Marshal(data)
+	return v.ValidateInterfaceWith(data, json.Marshal)
+}
+
+// ValidateInterfaceWith validates an interface{} against the schema, turning it
+// into JSON with marshal instead of json.Marshal (e.g. a marshaler that keeps
+// zero values of omitempty fields)
+func (v *Validator) ValidateInterfaceWith(data interface{}, marshal func(interface{}) ([]byte, error)) (*ValidationResult, error) {
+	jsonBytes, err := marshal(data)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao serializar dados para JSON: %w", err)
 	}
@@ -261,72 +744,323 @@ type MiddlewareConfig struct {
 	SkipMethods []string
 	// ErrorHandler custom function to handle validation errors
 	ErrorHandler func(w http.ResponseWriter, r *http.Request, result *ValidationResult)
+	// PartialMethods HTTP methods validated with partial semantics, ignoring required (e.g. PATCH)
+	PartialMethods []string
+	// PartialStripNested also ignores required in nested objects for partial methods
+	PartialStripNested bool
+	// AllowEmptyBody HTTP methods for which a zero-length body skips validation
+	// and reaches the handler (e.g. POST endpoints with an optional body)
+	AllowEmptyBody []string
+	// ErrorStatusCode HTTP status used by the default error handler (default: 400)
+	ErrorStatusCode int
+	// Direction enforces readOnly (DirectionWrite) or writeOnly (DirectionRead) properties,
+	// including on requests validated with partial semantics
+	Direction Direction
+	// RedactValues omits the offending values from the errors passed to the ErrorHandler
+	RedactValues bool
+	// SkipFunc skips validation when it returns true, in addition to SkipMethods
+	SkipFunc func(r *http.Request) bool
+	// WarningHandler receives the warnings of requests that passed validation (default: log)
+	WarningHandler func(r *http.Request, warnings []ValidationError)
+	// DeprecationHandler receives the deprecated fields present in requests that
+	// passed validation, before next runs (default: sets the Deprecation and
+	// Warning headers). Requires the WarnDeprecated option.
+	DeprecationHandler func(w http.ResponseWriter, r *http.Request, deprecations []ValidationError)
+	// MaxBodyBytes rejects bodies larger than this many bytes with 413 (default: no limit)
+	MaxBodyBytes int64
+	// BodyReadHook receives the number of bytes read from each validated request,
+	// whatever the validation outcome
+	BodyReadHook func(n int64)
+	// SummaryOnly makes the default error handler respond with a single summary
+	// message instead of the details array
+	SummaryOnly bool
+	// ErrorLogHook receives the full result of every request that failed
+	// validation, after RedactValues is applied
+	ErrorLogHook func(r *http.Request, result *ValidationResult)
+	// MultipartJSONField validates, in multipart/form-data requests, the JSON
+	// held by this form field or file part instead of the body
+	MultipartJSONField string
+	// DocsBaseURL links each error passed to the ErrorHandler to its documentation,
+	// as DocsBaseURL#<field>-<keyword> (e.g. https://docs.example.com/users#email-format)
+	DocsBaseURL string
+	// SampleRate validates only this fraction of the requests, between 0 and 1;
+	// the others reach the handler without validation (default: every request)
+	SampleRate float64
+	// SampleHook receives, for every request not skipped by method or SkipFunc,
+	// whether it was sampled for validation, to count sampled and skipped requests
+	SampleHook func(r *http.Request, sampled bool)
+	// Timeout responds 503 when reading the body plus validating it takes longer
+	// than this (default: no limit). Requests canceled by the client meanwhile
+	// get no response.
+	Timeout time.Duration
+	// IncludeRequestID makes the default error handler copy the request's
+	// correlation ID into ErrorResponse.RequestID and echo it in the response header
+	IncludeRequestID bool
+	// RequestIDHeader header holding the correlation ID (default: X-Request-ID)
+	RequestIDHeader string
+	// HeaderSchema validates the request headers named in its top-level
+	// properties before the body is read, failures reaching the ErrorHandler.
+	// Header names are matched case-insensitively and keyed as the schema spells
+	// them; a repeated header becomes an array of strings.
+	HeaderSchema *Validator
+	// GraphQLVariables validates only the variables object of GraphQL request
+	// bodies against the schema; query and operationName are neither validated
+	// nor changed, and absent or null variables are validated as {}
+	GraphQLVariables bool
+	// GraphQLVariablesField body member holding the variables (default: variables)
+	GraphQLVariablesField string
+	// IncludeMeta makes the default error handler add ErrorResponse.Meta, which
+	// reveals the schema $id or hash to clients
+	IncludeMeta bool
+	// UnknownVersionHandler responds, in MiddlewareByVersion, to requests whose
+	// version has no registered validator (default: 400)
+	UnknownVersionHandler http.HandlerFunc
 }
 
 // MiddlewareWithConfig returns an HTTP middleware with custom settings
 func (v *Validator) MiddlewareWithConfig(config MiddlewareConfig, next http.HandlerFunc) http.HandlerFunc {
-	// Default methods that skip validation
-	if len(config.SkipMethods) == 0 {
-		config.SkipMethods = []string{"GET", "DELETE", "HEAD", "OPTIONS"}
-	}
-
-	// Standard error handler
-	if config.ErrorHandler == nil {
-		config.ErrorHandler = v.defaultErrorHandler
-	}
+	config = v.middlewareConfig(config)
 
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Checks whether to skip validation for this method
-		for _, method := range config.SkipMethods {
-			if r.Method == method {
-				next(w, r)
-				return
-			}
+		if skipValidation(r, config) {
+			next(w, r)
+			return
 		}
 
-		validation, err := v.ValidateRequest(r)
+		reportBodyRead := limitRequestBody(w, r, config)
+
+		validation, err := v.validateWithTimeout(r, config)
+		reportBodyRead()
+
 		if err != nil {
+			if requestCanceled(r, err) || bodyTooLarge(w, err) || validationTimedOut(w, err) {
+				return
+			}
 			http.Error(w, fmt.Sprintf("Erro interno de validação: %s", err.Error()),
 				http.StatusInternalServerError)
 			return
 		}
 
-		if !validation.Valid {
-			config.ErrorHandler(w, r, validation)
-			return
+		handleValidation(w, r, config, validation, next)
+	}
+}
+
+// middlewareConfig fills the defaults of config and its error handler: the
+// one set on the validator, then the standard one
+func (v *Validator) middlewareConfig(config MiddlewareConfig) MiddlewareConfig {
+	config = middlewareDefaults(config)
+
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = v.defaultHandler()
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = v.defaultErrorHandler(config)
+	}
+	return config
+}
+
+// middlewareDefaults fills the settings left empty in config, except the ErrorHandler
+func middlewareDefaults(config MiddlewareConfig) MiddlewareConfig {
+	// Default methods that skip validation
+	if len(config.SkipMethods) == 0 {
+		config.SkipMethods = []string{"GET", "DELETE", "HEAD", "OPTIONS"}
+	}
+
+	// Default status for invalid data
+	if config.ErrorStatusCode == 0 {
+		config.ErrorStatusCode = http.StatusBadRequest
+	}
+
+	// Default correlation ID header
+	if config.IncludeRequestID && config.RequestIDHeader == "" {
+		config.RequestIDHeader = "X-Request-ID"
+	}
+
+	// Default GraphQL variables member
+	if config.GraphQLVariables && config.GraphQLVariablesField == "" {
+		config.GraphQLVariablesField = "variables"
+	}
+
+	// Standard warning handler
+	if config.WarningHandler == nil {
+		config.WarningHandler = defaultWarningHandler
+	}
+
+	// Standard deprecation handler
+	if config.DeprecationHandler == nil {
+		config.DeprecationHandler = defaultDeprecationHandler
+	}
+
+	return config
+}
+
+// skipValidation reports whether the request skips validation, by method, by
+// SkipFunc or by falling outside the sampled fraction
+func skipValidation(r *http.Request, config MiddlewareConfig) bool {
+	if containsMethod(config.SkipMethods, r.Method) || (config.SkipFunc != nil && config.SkipFunc(r)) {
+		return true
+	}
+	return !sampleRequest(r, config)
+}
+
+// handleValidation passes an invalid request to the ErrorHandler, and a valid
+// one to next after reporting its warnings and deprecated fields
+func handleValidation(w http.ResponseWriter, r *http.Request, config MiddlewareConfig, validation *ValidationResult, next http.HandlerFunc) {
+	if !validation.Valid {
+		if config.RedactValues {
+			validation.RedactValues()
+		}
+		if config.DocsBaseURL != "" {
+			addDocsURLs(validation, config.DocsBaseURL)
+		}
+		if config.ErrorLogHook != nil {
+			config.ErrorLogHook(r, validation)
 		}
+		config.ErrorHandler(w, r, validation)
+		return
+	}
+
+	if warnings := validation.Warnings(); len(warnings) > 0 {
+		config.WarningHandler(r, warnings)
+	}
 
-		next(w, r)
+	if deprecations := validation.Deprecations(); len(deprecations) > 0 {
+		config.DeprecationHandler(w, r, deprecations)
 	}
+
+	next(w, r)
 }
 
-// defaultErrorHandler is the default error handler for the middleware
-func (v *Validator) defaultErrorHandler(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusBadRequest)
+// containsMethod reports whether method is listed in methods
+func containsMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// SetDefaultErrorHandler sets the error handler used by the middlewares of this
+// validator created afterwards without an ErrorHandler in their config
+func (v *Validator) SetDefaultErrorHandler(fn func(w http.ResponseWriter, r *http.Request, result *ValidationResult)) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.errorHandler = fn
+}
+
+// defaultHandler returns the error handler set with SetDefaultErrorHandler
+func (v *Validator) defaultHandler() func(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.errorHandler
+}
 
-	response := ErrorResponse{
-		Error:   "Dados de entrada inválidos",
-		Details: result.Errors,
+// validateMiddlewareRequest reads the document of a request, from the body or
+// from the configured multipart field, and validates it with the semantics
+// configured for the request method
+func (v *Validator) validateMiddlewareRequest(ctx context.Context, r *http.Request, config MiddlewareConfig) (*ValidationResult, error) {
+	// Invalid headers reject the request without reading the body
+	if config.HeaderSchema != nil {
+		result, err := validateHeaders(config.HeaderSchema, r)
+		if err != nil || !result.Valid {
+			return result, err
+		}
 	}
 
-	json.NewEncoder(w).Encode(response)
+	var data []byte
+	var err error
+	if config.MultipartJSONField != "" && isMultipartRequest(r) {
+		var found bool
+		data, found, err = readMultipartField(r, config.MultipartJSONField)
+		if err == nil && !found {
+			return missingMultipartField(config.MultipartJSONField), nil
+		}
+	} else {
+		data, err = readRequestBody(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// An absent body is accepted as is for the methods that opt in
+	if len(data) == 0 && containsMethod(config.AllowEmptyBody, r.Method) {
+		return &ValidationResult{Valid: true}, nil
+	}
+
+	if config.GraphQLVariables && len(data) > 0 {
+		var result *ValidationResult
+		if data, result = graphQLVariables(data, config.GraphQLVariablesField); result != nil {
+			return result, nil
+		}
+	}
+
+	// Partial methods such as PATCH still honor the direction of the request
+	partial := containsMethod(config.PartialMethods, r.Method)
+	switch {
+	case config.Direction != DirectionNone:
+		return v.validateDirection(data, config.Direction, partial, config.PartialStripNested)
+	case partial:
+		return v.ValidatePartialWith(data, config.PartialStripNested)
+	default:
+		return v.ValidateBytesContext(ctx, data)
+	}
 }
 
-// MultiValidator manages multiple validators
+// defaultErrorHandler returns the default error handler for the middleware, responding with status
+func (v *Validator) defaultErrorHandler(config MiddlewareConfig) func(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
+	return func(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
+		response := NewErrorResponse(result, "Dados de entrada inválidos")
+		if config.SummaryOnly {
+			response = ErrorResponse{Error: result.Summary()}
+		}
+
+		if config.IncludeRequestID {
+			if requestID := r.Header.Get(config.RequestIDHeader); requestID != "" {
+				response.RequestID = requestID
+				w.Header().Set(config.RequestIDHeader, requestID)
+			}
+		}
+
+		// Without a *Validator, as in MiddlewareFor with a fake, there is no schema to describe
+		if config.IncludeMeta && v != nil {
+			response.Meta = &ErrorMeta{
+				Timestamp:     time.Now().UTC().Format(time.RFC3339),
+				SchemaVersion: v.schemaVersion(),
+			}
+		}
+
+		response.WriteJSON(w, config.ErrorStatusCode)
+	}
+}
+
+// MultiValidator manages multiple validators, safe for concurrent use
 type MultiValidator struct {
+	mu         sync.RWMutex
 	validators map[string]*Validator
+	building   map[string]*pendingBuild
+	strategy   MatchStrategy // Selection made by ValidateAny
+}
+
+// pendingBuild tracks an in-flight GetOrAdd construction
+type pendingBuild struct {
+	done      chan struct{}
+	validator *Validator
+	err       error
 }
 
 // NewMultiValidator creates a new multiple validator manager
 func NewMultiValidator() *MultiValidator {
 	return &MultiValidator{
 		validators: make(map[string]*Validator),
+		building:   make(map[string]*pendingBuild),
 	}
 }
 
 // Add adds a validator with a specific key
 func (mv *MultiValidator) Add(key string, validator *Validator) {
+	mv.mu.Lock()
+	defer mv.mu.Unlock()
 	mv.validators[key] = validator
 }
 
@@ -350,19 +1084,95 @@ func (mv *MultiValidator) AddFromString(key, schemaJSON string) error {
 	return nil
 }
 
+// AddFromBytes adds a validator from bytes
+func (mv *MultiValidator) AddFromBytes(key string, schemaBytes []byte) error {
+	validator, err := NewFromBytes(schemaBytes)
+	if err != nil {
+		return err
+	}
+	mv.Add(key, validator)
+	return nil
+}
+
+// AddFromFS adds a validator from a file in a file system (e.g. an embed.FS)
+func (mv *MultiValidator) AddFromFS(key string, fsys fs.FS, path string) error {
+	schemaBytes, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return fmt.Errorf("erro ao ler arquivo de schema '%s': %w", path, err)
+	}
+	return mv.AddFromBytes(key, schemaBytes)
+}
+
 // Get returns a validator by key
 func (mv *MultiValidator) Get(key string) (*Validator, bool) {
+	mv.mu.RLock()
+	defer mv.mu.RUnlock()
 	validator, exists := mv.validators[key]
 	return validator, exists
 }
 
+// GetOrAdd returns the validator registered under key or builds, stores and
+// returns a new one. Concurrent callers for the same key share a single build,
+// and a failed build is not stored so later calls can retry. A build that
+// panics fails with an error for every caller sharing it.
+func (mv *MultiValidator) GetOrAdd(key string, build func() (*Validator, error)) (*Validator, error) {
+	mv.mu.Lock()
+	if validator, exists := mv.validators[key]; exists {
+		mv.mu.Unlock()
+		return validator, nil
+	}
+
+	if pending, exists := mv.building[key]; exists {
+		mv.mu.Unlock()
+		<-pending.done
+		return pending.validator, pending.err
+	}
+
+	pending := &pendingBuild{done: make(chan struct{})}
+	mv.building[key] = pending
+	mv.mu.Unlock()
+
+	// Waiting callers are released even when build panics
+	defer func() {
+		mv.mu.Lock()
+		delete(mv.building, key)
+		if pending.err == nil {
+			mv.validators[key] = pending.validator
+		}
+		mv.mu.Unlock()
+		close(pending.done)
+	}()
+
+	pending.validator, pending.err = runBuild(key, build)
+	return pending.validator, pending.err
+}
+
+// runBuild calls build, turning a panic or a nil validator into an error
+func runBuild(key string, build func() (*Validator, error)) (validator *Validator, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			validator, err = nil, fmt.Errorf("construção do validator '%s' entrou em pânico: %v", key, recovered)
+		}
+	}()
+
+	validator, err = build()
+	if err == nil && validator == nil {
+		err = fmt.Errorf("construção do validator '%s' retornou nil", key)
+	}
+	return validator, err
+}
+
 // Remove removes a validator
 func (mv *MultiValidator) Remove(key string) {
+	mv.mu.Lock()
+	defer mv.mu.Unlock()
 	delete(mv.validators, key)
 }
 
 // Keys returns all validator keys
 func (mv *MultiValidator) Keys() []string {
+	mv.mu.RLock()
+	defer mv.mu.RUnlock()
 	keys := make([]string, 0, len(mv.validators))
 	for key := range mv.validators {
 		keys = append(keys, key)
@@ -372,5 +1182,7 @@ func (mv *MultiValidator) Keys() []string {
 
 // Count returns the number of registered validators
 func (mv *MultiValidator) Count() int {
+	mv.mu.RLock()
+	defer mv.mu.RUnlock()
 	return len(mv.validators)
 }
@@ -2,12 +2,21 @@ package valid
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
+	"io/fs"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/fstest"
+	"time"
 )
 
 const testSchema = `{
@@ -166,6 +175,52 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewFromEnv(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "test-schema-*.json")
+	if err != nil {
+		t.Fatalf("erro ao criar arquivo temporário: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.WriteString(testSchema); err != nil {
+		t.Fatalf("erro ao escrever no arquivo temporário: %v", err)
+	}
+	tmpFile.Close()
+
+	// Schema file path
+	t.Setenv("TEST_SCHEMA", tmpFile.Name())
+	validator, err := NewFromEnv("TEST_SCHEMA")
+	if err != nil {
+		t.Errorf("não esperava erro, mas recebeu: %v", err)
+	}
+	if validator == nil {
+		t.Error("esperava validator válido")
+	}
+
+	// Inline schema
+	t.Setenv("TEST_SCHEMA", testSchema)
+	validator, err = NewFromEnv("TEST_SCHEMA")
+	if err != nil {
+		t.Errorf("não esperava erro, mas recebeu: %v", err)
+	}
+	if validator == nil {
+		t.Error("esperava validator válido")
+	}
+
+	// Missing file surfaces the file error
+	t.Setenv("TEST_SCHEMA", "arquivo-inexistente.json")
+	_, err = NewFromEnv("TEST_SCHEMA")
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("esperava erro de arquivo inexistente, recebeu: %v", err)
+	}
+
+	// Unset variable
+	_, err = NewFromEnv("TEST_SCHEMA_INEXISTENTE")
+	if err == nil {
+		t.Error("esperava erro para variável não definida")
+	}
+}
+
 func TestValidateString(t *testing.T) {
 	validator, err := NewFromString(testSchema)
 	if err != nil {
@@ -361,6 +416,49 @@ func TestValidateInterface(t *testing.T) {
 	}
 }
 
+func TestValidateInterfaceWith(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {"quantity": {"type": "integer", "minimum": 1}},
+		"required": ["quantity"]
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	type item struct {
+		Quantity int `json:"quantity,omitempty"`
+	}
+
+	// json.Marshal drops the zero value, reported as a missing field
+	result, err := validator.ValidateInterface(item{})
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || result.Errors[0].Constraint != "required" {
+		t.Errorf("esperava erro de campo obrigatório, recebeu %+v", result.Errors)
+	}
+
+	// A marshaler keeping zero values reports the actual violation
+	keepZero := func(data interface{}) ([]byte, error) {
+		return json.Marshal(map[string]interface{}{"quantity": data.(item).Quantity})
+	}
+	result, err = validator.ValidateInterfaceWith(item{}, keepZero)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || result.Errors[0].Field != "quantity" || result.Errors[0].Constraint != "number_gte" {
+		t.Errorf("esperava erro de mínimo em 'quantity', recebeu %+v", result.Errors)
+	}
+
+	_, err = validator.ValidateInterfaceWith(item{}, func(interface{}) ([]byte, error) {
+		return nil, errors.New("falha")
+	})
+	if err == nil || !strings.Contains(err.Error(), "erro ao serializar dados para JSON: falha") {
+		t.Errorf("esperava erro do marshaler, recebeu %v", err)
+	}
+}
+
 func TestValidateRequest(t *testing.T) {
 	validator, err := NewFromString(testSchema)
 	if err != nil {
@@ -532,6 +630,86 @@ func TestMiddlewareWithConfig(t *testing.T) {
 	}
 }
 
+func TestMiddlewareErrorStatusCode(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		ErrorStatusCode: http.StatusUnprocessableEntity,
+	}, handler)
+
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "T"}`))
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("esperava status 422, recebeu %d", w.Code)
+	}
+
+	var errorResponse ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&errorResponse); err != nil {
+		t.Errorf("erro ao decodificar resposta de erro: %v", err)
+	}
+	if len(errorResponse.Details) == 0 {
+		t.Error("resposta de erro deveria ter detalhes")
+	}
+}
+
+func TestMiddlewareSkipFunc(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handlerCalled := false
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		w.WriteHeader(http.StatusOK)
+	}
+
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		SkipFunc: func(r *http.Request) bool {
+			return r.Header.Get("X-Skip-Validation") == "true"
+		},
+	}, handler)
+
+	// Header present: validation is bypassed
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "T"}`))
+	req.Header.Set("X-Skip-Validation", "true")
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if !handlerCalled {
+		t.Error("handler deveria ter sido chamado quando SkipFunc retorna true")
+	}
+
+	// Header absent: invalid data is rejected
+	req = httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "T"}`))
+	w = httptest.NewRecorder()
+	handlerCalled = false
+	middleware(w, req)
+
+	if handlerCalled {
+		t.Error("handler não deveria ter sido chamado para dados inválidos")
+	}
+
+	// Default SkipMethods still apply
+	req = httptest.NewRequest("GET", "/test", nil)
+	w = httptest.NewRecorder()
+	handlerCalled = false
+	middleware(w, req)
+
+	if !handlerCalled {
+		t.Error("handler deveria ter sido chamado para GET")
+	}
+}
+
 func TestMultiValidator(t *testing.T) {
 	mv := NewMultiValidator()
 
@@ -602,6 +780,123 @@ func TestMultiValidator(t *testing.T) {
 	}
 }
 
+func TestMultiValidatorAddFromBytesAndFS(t *testing.T) {
+	mv := NewMultiValidator()
+
+	if err := mv.AddFromBytes("user", []byte(testSchema)); err != nil {
+		t.Errorf("erro ao adicionar validator: %v", err)
+	}
+	if _, exists := mv.Get("user"); !exists {
+		t.Error("validator 'user' deveria existir")
+	}
+
+	if err := mv.AddFromBytes("invalid", []byte(`{"type": "object"`)); err == nil {
+		t.Error("esperava erro para schema inválido")
+	}
+
+	fsys := fstest.MapFS{
+		"schemas/user.json": &fstest.MapFile{Data: []byte(testSchema)},
+	}
+
+	if err := mv.AddFromFS("fs-user", fsys, "schemas/user.json"); err != nil {
+		t.Errorf("erro ao adicionar validator do fs: %v", err)
+	}
+	if _, exists := mv.Get("fs-user"); !exists {
+		t.Error("validator 'fs-user' deveria existir")
+	}
+
+	err := mv.AddFromFS("missing", fsys, "schemas/inexistente.json")
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("esperava erro de arquivo inexistente, recebeu: %v", err)
+	}
+
+	if mv.Count() != 2 {
+		t.Errorf("esperava 2 validators, recebeu %d", mv.Count())
+	}
+}
+
+func TestMultiValidatorGetOrAdd(t *testing.T) {
+	mv := NewMultiValidator()
+
+	var builds int32
+	build := func() (*Validator, error) {
+		atomic.AddInt32(&builds, 1)
+		time.Sleep(10 * time.Millisecond)
+		return NewFromString(testSchema)
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*Validator, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			validator, err := mv.GetOrAdd("user", build)
+			if err != nil {
+				t.Errorf("não esperava erro, mas recebeu: %v", err)
+			}
+			results[i] = validator
+		}(i)
+	}
+	wg.Wait()
+
+	if builds != 1 {
+		t.Errorf("esperava 1 construção, recebeu %d", builds)
+	}
+	for _, validator := range results {
+		if validator == nil || validator != results[0] {
+			t.Fatal("todas as chamadas deveriam receber o mesmo validator")
+		}
+	}
+
+	// A failed build is not stored
+	_, err := mv.GetOrAdd("broken", func() (*Validator, error) {
+		return NewFromString("")
+	})
+	if err == nil {
+		t.Error("esperava erro de construção")
+	}
+	if _, exists := mv.Get("broken"); exists {
+		t.Error("validator com falha não deveria ser registrado")
+	}
+
+	// A panicking build fails the callers sharing it instead of blocking them
+	started := make(chan struct{})
+	panicked := make(chan error, 1)
+	go func() {
+		_, err := mv.GetOrAdd("panic", func() (*Validator, error) {
+			close(started)
+			time.Sleep(10 * time.Millisecond)
+			panic("falha no build")
+		})
+		panicked <- err
+	}()
+	<-started
+
+	waiter := make(chan error, 1)
+	go func() {
+		_, err := mv.GetOrAdd("panic", build)
+		waiter <- err
+	}()
+
+	for _, ch := range []chan error{panicked, waiter} {
+		select {
+		case err := <-ch:
+			if ch == panicked && err == nil {
+				t.Error("esperava erro do build com pânico")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("chamada de GetOrAdd bloqueada após pânico no build")
+		}
+	}
+
+	// and a later call builds again
+	validator, err := mv.GetOrAdd("panic", build)
+	if err != nil || validator == nil {
+		t.Errorf("esperava nova construção após o pânico, recebeu %v", err)
+	}
+}
+
 func TestValidationErrorStructure(t *testing.T) {
 	validator, err := NewFromString(testSchema)
 	if err != nil {
@@ -697,6 +992,61 @@ func BenchmarkValidateBytes(b *testing.B) {
 		"age": 30
 	}`)
 
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := validator.ValidateBytes(validJSON)
+		if err != nil {
+			b.Fatalf("erro durante benchmark: %v", err)
+		}
+	}
+}
+
+// BenchmarkValidateBytesLargeDocument reports the allocations of validating a
+// larger payload, run with -benchmem to compare allocs/op between versions
+func BenchmarkValidateBytesLargeDocument(b *testing.B) {
+	validator, err := NewFromString(`{
+		"type": "array",
+		"items": ` + testSchema + `
+	}`)
+	if err != nil {
+		b.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	items := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		items = append(items, `{"name": "João Silva", "email": "joao@exemplo.com", "age": 30}`)
+	}
+	validJSON := []byte("[" + strings.Join(items, ",") + "]")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := validator.ValidateBytes(validJSON)
+		if err != nil {
+			b.Fatalf("erro durante benchmark: %v", err)
+		}
+	}
+}
+
+// BenchmarkValidateBytesLargeDocumentSkipPrecheck is BenchmarkValidateBytesLargeDocument
+// with the SkipJSONPrecheck option, to compare the cost of the json.Valid scan
+func BenchmarkValidateBytesLargeDocumentSkipPrecheck(b *testing.B) {
+	validator, err := NewFromBytesWithOptions([]byte(`{
+		"type": "array",
+		"items": `+testSchema+`
+	}`), Options{SkipJSONPrecheck: true})
+	if err != nil {
+		b.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	items := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		items = append(items, `{"name": "João Silva", "email": "joao@exemplo.com", "age": 30}`)
+	}
+	validJSON := []byte("[" + strings.Join(items, ",") + "]")
+
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, err := validator.ValidateBytes(validJSON)
@@ -726,3 +1076,500 @@ func BenchmarkMiddleware(b *testing.B) {
 		middleware(w, req)
 	}
 }
+
+func TestNewResolvesSiblingRefs(t *testing.T) {
+	dir := t.TempDir()
+
+	common := `{
+		"definitions": {
+			"Address": {
+				"type": "object",
+				"properties": {"city": {"type": "string"}},
+				"required": ["city"]
+			}
+		}
+	}`
+	schema := `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"address": {"$ref": "common.json#/definitions/Address"}
+		},
+		"required": ["name", "address"]
+	}`
+
+	if err := os.WriteFile(filepath.Join(dir, "common.json"), []byte(common), 0o644); err != nil {
+		t.Fatalf("erro ao escrever arquivo: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "person.json"), []byte(schema), 0o644); err != nil {
+		t.Fatalf("erro ao escrever arquivo: %v", err)
+	}
+
+	validator, err := New(filepath.Join(dir, "person.json"))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "Ana", "address": {}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Constraint != "required" {
+		t.Errorf("esperava erro do schema referenciado, recebeu %+v", result.Errors)
+	}
+
+	result, _ = validator.ValidateString(`{"name": "Ana", "address": {"city": "Recife"}}`)
+	if !result.Valid {
+		t.Errorf("esperava dados válidos, recebeu %+v", result.Errors)
+	}
+
+	// Derived validators keep resolving the sibling file
+	result, err = validator.ValidatePartial([]byte(`{"address": {"city": 1}}`))
+	if err != nil {
+		t.Fatalf("não esperava erro na validação parcial, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Error("esperava erro de tipo na validação parcial")
+	}
+}
+
+func TestValidationResultByField(t *testing.T) {
+	result := &ValidationResult{Errors: []ValidationError{
+		{Field: "name", Message: "muito curto"},
+		{Field: "address.zip", Message: "formato inválido"},
+		{Field: "name", Message: "caractere inválido"},
+		{Field: "", Message: "propriedade adicional"},
+	}}
+
+	expected := map[string][]string{
+		"name":        {"muito curto", "caractere inválido"},
+		"address.zip": {"formato inválido"},
+		RootFieldKey:  {"propriedade adicional"},
+	}
+	if grouped := result.ByField(); !reflect.DeepEqual(grouped, expected) {
+		t.Errorf("esperava %v, recebeu %v", expected, grouped)
+	}
+
+	if grouped := (&ValidationResult{Valid: true}).ByField(); len(grouped) != 0 {
+		t.Errorf("esperava mapa vazio para resultado válido, recebeu %v", grouped)
+	}
+}
+
+func TestMergeResults(t *testing.T) {
+	schemaErr := ValidationError{Field: "name", Message: "nome curto", Severity: SeverityError}
+	warning := ValidationError{Field: "nickname", Message: "obsoleto", Severity: SeverityWarning}
+	crossErr := ValidationError{Field: "endDate", Message: "antes do início", Severity: SeverityError}
+
+	result := &ValidationResult{Valid: true, Errors: []ValidationError{warning}}
+	result.Merge(&ValidationResult{Valid: false, Errors: []ValidationError{crossErr}})
+	result.Merge(nil)
+	if result.Valid || !reflect.DeepEqual(result.Errors, []ValidationError{warning, crossErr}) {
+		t.Errorf("resultado inesperado após Merge: %+v", result)
+	}
+
+	tests := []struct {
+		name    string
+		results []*ValidationResult
+		valid   bool
+		errors  []ValidationError
+	}{
+		{"nenhum resultado", nil, true, nil},
+		{"todos válidos", []*ValidationResult{{Valid: true}, {Valid: true, Errors: []ValidationError{warning}}}, true, []ValidationError{warning}},
+		{"um inválido", []*ValidationResult{{Valid: false, Errors: []ValidationError{schemaErr}}, nil, {Valid: false, Errors: []ValidationError{crossErr}}}, false, []ValidationError{schemaErr, crossErr}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := MergeResults(tt.results...)
+			if merged.Valid != tt.valid || !reflect.DeepEqual(merged.Errors, tt.errors) {
+				t.Errorf("esperava Valid=%v com %+v, recebeu %+v", tt.valid, tt.errors, merged)
+			}
+		})
+	}
+
+	// The inputs are not changed
+	first := &ValidationResult{Valid: true}
+	MergeResults(first, &ValidationResult{Valid: false, Errors: []ValidationError{schemaErr}})
+	if !first.Valid || len(first.Errors) != 0 {
+		t.Errorf("MergeResults não deveria alterar os resultados de entrada, recebeu %+v", first)
+	}
+}
+
+func TestValidationErrorDetails(t *testing.T) {
+	validator, err := NewFromString(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 3},
+			"age": {"type": "integer", "minimum": 18}
+		},
+		"required": ["email"]
+	}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "Al", "age": 16}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	details := make(map[string]map[string]interface{})
+	for _, validationErr := range result.Errors {
+		details[validationErr.Constraint] = validationErr.Details
+	}
+
+	if min := details["string_gte"]["min"]; min != 3 {
+		t.Errorf("esperava min 3 para minLength, recebeu %v (%T)", min, min)
+	}
+	if min := details["number_gte"]["min"]; min != json.Number("18") {
+		t.Errorf("esperava min 18 para minimum, recebeu %v (%T)", min, min)
+	}
+	if property := details["required"]["property"]; property != "email" {
+		t.Errorf("esperava property 'email' para required, recebeu %v", property)
+	}
+	for constraint, constraintDetails := range details {
+		if _, ok := constraintDetails["field"]; ok {
+			t.Errorf("%s: não esperava 'field' nos detalhes", constraint)
+		}
+		if _, ok := constraintDetails["context"]; ok {
+			t.Errorf("%s: não esperava 'context' nos detalhes", constraint)
+		}
+	}
+
+	encoded, err := json.Marshal(result.Errors)
+	if err != nil {
+		t.Fatalf("erro ao serializar erros: %v", err)
+	}
+	if !strings.Contains(string(encoded), `"details":{"min":18}`) {
+		t.Errorf("esperava o limite serializado como número, recebeu %s", encoded)
+	}
+}
+
+func TestValidationResultHelpers(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"name": "A", "email": "ana@x.com", "age": 200}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	first := result.FirstError()
+	if first == nil || first != &result.Errors[0] {
+		t.Fatalf("esperava o primeiro erro, recebeu %+v", first)
+	}
+	if !result.HasFieldError("age") || !result.HasFieldError("name") {
+		t.Errorf("esperava erros em 'age' e 'name', recebeu %+v", result.Errors)
+	}
+	if result.HasFieldError("email") {
+		t.Error("não esperava erro em 'email'")
+	}
+
+	result, _ = validator.ValidateString(`{"name": "Ana", "email": "ana@x.com"}`)
+	if result.FirstError() != nil {
+		t.Error("esperava nil para resultado válido")
+	}
+
+	// Warnings are skipped by FirstError
+	result = &ValidationResult{Errors: []ValidationError{
+		{Field: "nickname", Severity: SeverityWarning},
+		{Field: "name", Severity: SeverityError},
+	}}
+	if first := result.FirstError(); first == nil || first.Field != "name" {
+		t.Errorf("esperava o primeiro erro de nível error, recebeu %+v", first)
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	result := &ValidationResult{Errors: []ValidationError{{Field: "name", Message: "muito curto"}}}
+
+	response := NewErrorResponse(result, "Pedido inválido")
+	if response.Error != "Pedido inválido" || len(response.Details) != 1 {
+		t.Errorf("resposta inesperada: %+v", response)
+	}
+
+	w := httptest.NewRecorder()
+	if err := response.WriteJSON(w, http.StatusUnprocessableEntity); err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("esperava status 422, recebeu %d", w.Code)
+	}
+	if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("esperava Content-Type application/json, recebeu '%s'", contentType)
+	}
+
+	var decoded ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&decoded); err != nil {
+		t.Fatalf("erro ao decodificar resposta: %v", err)
+	}
+	if decoded.Error != "Pedido inválido" || decoded.Details[0].Field != "name" {
+		t.Errorf("corpo inesperado: %+v", decoded)
+	}
+
+	if response := NewErrorResponse(nil, "erro"); response.Details != nil {
+		t.Errorf("não esperava detalhes sem resultado, recebeu %+v", response.Details)
+	}
+}
+
+func TestMiddlewareAllowEmptyBody(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	handlerCalled := false
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		AllowEmptyBody: []string{"POST"},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Empty body on an allowed method: the handler runs
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(""))
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if !handlerCalled || w.Code != http.StatusOK {
+		t.Errorf("esperava o handler chamado com status 200, recebeu chamado=%v status=%d", handlerCalled, w.Code)
+	}
+
+	// A present body is still validated
+	req = httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "T"}`))
+	w = httptest.NewRecorder()
+	handlerCalled = false
+	middleware(w, req)
+
+	if handlerCalled || w.Code != http.StatusBadRequest {
+		t.Errorf("esperava status 400 para dados inválidos, recebeu chamado=%v status=%d", handlerCalled, w.Code)
+	}
+
+	// Empty body on a method not listed is not accepted
+	req = httptest.NewRequest("PUT", "/test", strings.NewReader(""))
+	w = httptest.NewRecorder()
+	handlerCalled = false
+	middleware(w, req)
+
+	if handlerCalled {
+		t.Error("handler não deveria ter sido chamado para corpo vazio em método não permitido")
+	}
+}
+
+func TestMiddlewareSummaryOnly(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	var logged *ValidationResult
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		SummaryOnly:  true,
+		ErrorLogHook: func(r *http.Request, result *ValidationResult) { logged = result },
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "A", "age": 200}`))
+	w := httptest.NewRecorder()
+	middleware(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("esperava status 400, recebeu %d", w.Code)
+	}
+
+	var errorResponse ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&errorResponse); err != nil {
+		t.Fatalf("erro ao decodificar resposta de erro: %v", err)
+	}
+	if len(errorResponse.Details) != 0 {
+		t.Errorf("não esperava detalhes com SummaryOnly, recebeu %+v", errorResponse.Details)
+	}
+	if !strings.HasPrefix(errorResponse.Error, "3 campos inválidos: ") {
+		t.Errorf("resumo inesperado: '%s'", errorResponse.Error)
+	}
+	for _, field := range []string{"name", "age", "(root)"} {
+		if !strings.Contains(errorResponse.Error, field) {
+			t.Errorf("esperava '%s' no resumo, recebeu '%s'", field, errorResponse.Error)
+		}
+	}
+
+	if logged == nil || len(logged.Errors) != 3 {
+		t.Errorf("esperava o resultado completo no hook de log, recebeu %+v", logged)
+	}
+}
+
+func TestMiddlewareRequestID(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		config   MiddlewareConfig
+		header   string
+		expectID string
+	}{
+		{name: "default header", config: MiddlewareConfig{IncludeRequestID: true}, header: "X-Request-ID", expectID: "abc-123"},
+		{name: "custom header", config: MiddlewareConfig{IncludeRequestID: true, RequestIDHeader: "X-Correlation-ID"}, header: "X-Correlation-ID", expectID: "abc-123"},
+		{name: "disabled", config: MiddlewareConfig{}, header: "X-Request-ID"},
+		{name: "summary only", config: MiddlewareConfig{IncludeRequestID: true, SummaryOnly: true}, header: "X-Request-ID", expectID: "abc-123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middleware := validator.MiddlewareWithConfig(tt.config, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest("POST", "/test", strings.NewReader(`{"name": "A"}`))
+			req.Header.Set(tt.header, "abc-123")
+			w := httptest.NewRecorder()
+			middleware(w, req)
+
+			var errorResponse ErrorResponse
+			if err := json.NewDecoder(w.Body).Decode(&errorResponse); err != nil {
+				t.Fatalf("erro ao decodificar resposta de erro: %v", err)
+			}
+			if errorResponse.RequestID != tt.expectID {
+				t.Errorf("esperava requestId '%s', recebeu '%s'", tt.expectID, errorResponse.RequestID)
+			}
+			if got := w.Header().Get(tt.header); got != tt.expectID {
+				t.Errorf("esperava header %s='%s', recebeu '%s'", tt.header, tt.expectID, got)
+			}
+		})
+	}
+}
+
+func TestMiddlewareIncludeMeta(t *testing.T) {
+	hashed, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+	identified, err := NewFromString(`{"$id": "https://example.com/user.v2.json", "type": "object", "required": ["name"]}`)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		validator   *Validator
+		includeMeta bool
+		version     string
+	}{
+		{"desativado", hashed, false, ""},
+		{"hash do schema", hashed, true, hashed.SchemaHash()},
+		{"$id do schema", identified, true, "https://example.com/user.v2.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middleware := tt.validator.MiddlewareWithConfig(MiddlewareConfig{IncludeMeta: tt.includeMeta}, func(w http.ResponseWriter, r *http.Request) {})
+
+			before := time.Now().Add(-time.Second)
+			w := httptest.NewRecorder()
+			middleware(w, httptest.NewRequest("POST", "/test", strings.NewReader(`{"age": -1}`)))
+
+			var errorResponse ErrorResponse
+			if err := json.NewDecoder(w.Body).Decode(&errorResponse); err != nil {
+				t.Fatalf("erro ao decodificar resposta de erro: %v", err)
+			}
+
+			if !tt.includeMeta {
+				if errorResponse.Meta != nil {
+					t.Errorf("não esperava meta, recebeu %+v", errorResponse.Meta)
+				}
+				return
+			}
+			if errorResponse.Meta == nil {
+				t.Fatal("esperava meta na resposta")
+			}
+			if errorResponse.Meta.SchemaVersion != tt.version {
+				t.Errorf("esperava versão '%s', recebeu '%s'", tt.version, errorResponse.Meta.SchemaVersion)
+			}
+			timestamp, err := time.Parse(time.RFC3339, errorResponse.Meta.Timestamp)
+			if err != nil || timestamp.Before(before) {
+				t.Errorf("esperava timestamp RFC 3339 atual, recebeu '%s'", errorResponse.Meta.Timestamp)
+			}
+		})
+	}
+}
+
+func TestSetDefaultErrorHandler(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	validator.SetDefaultErrorHandler(func(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	invalid := `{"name": "A"}`
+
+	w := httptest.NewRecorder()
+	validator.Middleware(handler)(w, httptest.NewRequest("POST", "/test", strings.NewReader(invalid)))
+	if w.Code != http.StatusTeapot {
+		t.Errorf("esperava o handler padrão do validator (418), recebeu %d", w.Code)
+	}
+
+	// An ErrorHandler in the config overrides the validator default
+	middleware := validator.MiddlewareWithConfig(MiddlewareConfig{
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, result *ValidationResult) {
+			w.WriteHeader(http.StatusConflict)
+		},
+	}, handler)
+	w = httptest.NewRecorder()
+	middleware(w, httptest.NewRequest("POST", "/test", strings.NewReader(invalid)))
+	if w.Code != http.StatusConflict {
+		t.Errorf("esperava o handler da config (409), recebeu %d", w.Code)
+	}
+}
+
+func TestMissingRequired(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"required": ["name", "address"],
+		"properties": {
+			"name": {"type": "string", "minLength": 2},
+			"address": {
+				"type": "object",
+				"required": ["street", "city"],
+				"properties": {"street": {"type": "string"}, "city": {"type": "string"}}
+			}
+		}
+	}`
+
+	validator, err := NewFromString(schema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateString(`{"address": {"street": "Rua A"}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	missing := result.MissingRequired()
+	sort.Strings(missing)
+	if !reflect.DeepEqual(missing, []string{"address.city", "name"}) {
+		t.Errorf("esperava campos ausentes [address.city name], recebeu %v", missing)
+	}
+
+	// Other violations are not reported as missing fields
+	result, err = validator.ValidateString(`{"name": "A", "address": {"street": "Rua A", "city": "SP"}}`)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result.Valid {
+		t.Fatal("esperava dados inválidos")
+	}
+	if missing := result.MissingRequired(); len(missing) != 0 {
+		t.Errorf("não esperava campos ausentes, recebeu %v", missing)
+	}
+}
@@ -0,0 +1,93 @@
+package valid
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MatchStrategy selects the schema reported by MultiValidator.ValidateAny
+type MatchStrategy int
+
+const (
+	// MatchFirst returns the first schema, in key order, the data is valid against (default)
+	MatchFirst MatchStrategy = iota
+	// MatchFewestErrors validates the data against every schema and returns the
+	// one with the fewest violations, warnings included, so among several valid
+	// schemas the one without warnings wins
+	MatchFewestErrors
+)
+
+// SetMatchStrategy sets the strategy used by ValidateAny
+func (mv *MultiValidator) SetMatchStrategy(strategy MatchStrategy) {
+	mv.mu.Lock()
+	defer mv.mu.Unlock()
+	mv.strategy = strategy
+}
+
+// ValidateAny validates data against the registered schemas, for union
+// payloads with no discriminator, and returns the key of the matching schema
+// along with its result. Keys are tried in sorted order. When the data is
+// valid against none of them, the schema with the fewest error-level
+// violations is returned with its (invalid) result, as the closest match.
+func (mv *MultiValidator) ValidateAny(data []byte) (string, *ValidationResult, error) {
+	mv.mu.RLock()
+	strategy := mv.strategy
+	mv.mu.RUnlock()
+
+	keys := mv.Keys()
+	if len(keys) == 0 {
+		return "", nil, fmt.Errorf("nenhum validator registrado")
+	}
+	sort.Strings(keys)
+
+	bestKey := ""
+	var best *ValidationResult
+	for _, key := range keys {
+		validator, ok := mv.Get(key)
+		if !ok {
+			continue
+		}
+
+		result, err := validator.ValidateBytes(data)
+		if err != nil {
+			return "", nil, fmt.Errorf("erro ao validar com '%s': %w", key, err)
+		}
+
+		if strategy == MatchFirst && result.Valid {
+			return key, result, nil
+		}
+		if best == nil || closerMatch(result, best) {
+			bestKey, best = key, result
+		}
+	}
+
+	if best == nil {
+		return "", nil, fmt.Errorf("nenhum validator registrado")
+	}
+	return bestKey, best, nil
+}
+
+// closerMatch reports whether result is a closer match than best: valid
+// first, then fewer error-level violations, then fewer violations overall
+func closerMatch(result, best *ValidationResult) bool {
+	if result.Valid != best.Valid {
+		return result.Valid
+	}
+
+	errorCount, bestErrorCount := countErrors(result), countErrors(best)
+	if errorCount != bestErrorCount {
+		return errorCount < bestErrorCount
+	}
+	return len(result.Errors) < len(best.Errors)
+}
+
+// countErrors returns the number of error-level violations of a result
+func countErrors(result *ValidationResult) int {
+	count := 0
+	for _, validationErr := range result.Errors {
+		if validationErr.Severity == SeverityError {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,60 @@
+package valid
+
+import "testing"
+
+func TestValidateAny(t *testing.T) {
+	mv := NewMultiValidator()
+	schemas := map[string]string{
+		"card": `{"type": "object", "properties": {"number": {"type": "string", "minLength": 12}, "cvv": {"type": "string"}}, "required": ["number", "cvv"]}`,
+		"pix":  `{"type": "object", "properties": {"key": {"type": "string"}}, "required": ["key"]}`,
+		"any":  `{"type": "object", "properties": {"key": {"type": "string", "x-deprecated": true}}}`,
+	}
+	for key, schema := range schemas {
+		validator, err := NewFromBytesWithOptions([]byte(schema), Options{WarnDeprecated: true})
+		if err != nil {
+			t.Fatalf("erro ao criar validator '%s': %v", key, err)
+		}
+		mv.Add(key, validator)
+	}
+
+	tests := []struct {
+		name     string
+		strategy MatchStrategy
+		data     string
+		key      string
+		valid    bool
+	}{
+		{"primeira correspondência", MatchFirst, `{"key": "ana@x.com"}`, "any", true},
+		{"menos violações", MatchFewestErrors, `{"key": "ana@x.com"}`, "pix", true},
+		{"cartão", MatchFewestErrors, `{"number": "123456789012", "cvv": "123"}`, "any", true},
+		{"mais próximo sem correspondência", MatchFirst, `[]`, "any", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mv.SetMatchStrategy(tt.strategy)
+			key, result, err := mv.ValidateAny([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if key != tt.key || result.Valid != tt.valid {
+				t.Errorf("esperava '%s' (Valid=%v), recebeu '%s' (Valid=%v): %+v", tt.key, tt.valid, key, result.Valid, result.Errors)
+			}
+		})
+	}
+
+	// The closest schema is the one with the fewest errors
+	mv.Remove("any")
+	mv.SetMatchStrategy(MatchFirst)
+	key, result, err := mv.ValidateAny([]byte(`{"number": "123", "cvv": "123"}`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if key != "card" || result.Valid || len(result.Errors) != 1 {
+		t.Errorf("esperava os erros de 'card' como mais próximo, recebeu '%s': %+v", key, result.Errors)
+	}
+
+	if _, _, err := NewMultiValidator().ValidateAny([]byte(`{}`)); err == nil {
+		t.Error("esperava erro sem validators registrados")
+	}
+}
@@ -0,0 +1,85 @@
+package valid
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// vendorVersionPattern matches vendor media types carrying a version, such as
+// application/vnd.myapi.v2+json, capturing the version
+var vendorVersionPattern = regexp.MustCompile(`^application/vnd\.[^;]*\.(v[0-9]+)(\+json)?$`)
+
+// VersionFromAccept returns the version of the first vendor media type in the
+// Accept header, such as "v2" for application/vnd.myapi.v2+json, or "" when
+// there is none. It is meant as the headerParser of MiddlewareByVersion.
+func VersionFromAccept(r *http.Request) string {
+	for _, header := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(header, ",") {
+			mediaType := strings.TrimSpace(strings.SplitN(mediaRange, ";", 2)[0])
+			if match := vendorVersionPattern.FindStringSubmatch(strings.ToLower(mediaType)); match != nil {
+				return match[1]
+			}
+		}
+	}
+	return ""
+}
+
+// VersionFromHeader returns a headerParser for MiddlewareByVersion reading the
+// version from a custom header, such as X-Api-Version
+func VersionFromHeader(name string) func(r *http.Request) string {
+	return func(r *http.Request) string {
+		return strings.TrimSpace(r.Header.Get(name))
+	}
+}
+
+// MiddlewareByVersion returns a middleware that validates each request with the
+// validator registered under the version headerParser extracts from it, such
+// as VersionFromAccept or VersionFromHeader. Requests with an empty or
+// unregistered version reach the UnknownVersionHandler (default: 400), so a
+// parser should return the default version when the client sends none.
+// Requests skipped by SkipMethods or SkipFunc reach next without a version.
+// The options are those of MiddlewareFunc.
+func (mv *MultiValidator) MiddlewareByVersion(headerParser func(r *http.Request) string, opts ...MiddlewareOption) func(next http.HandlerFunc) http.HandlerFunc {
+	var config MiddlewareConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	unknown := config.UnknownVersionHandler
+	if unknown == nil {
+		unknown = unknownVersionHandler(headerParser)
+	}
+	skipMethods := middlewareDefaults(config).SkipMethods
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if containsMethod(skipMethods, r.Method) || (config.SkipFunc != nil && config.SkipFunc(r)) {
+				next(w, r)
+				return
+			}
+
+			version := headerParser(r)
+			validator, exists := mv.Get(version)
+			if version == "" || !exists {
+				unknown(w, r)
+				return
+			}
+
+			validator.MiddlewareWithConfig(config, next)(w, r)
+		}
+	}
+}
+
+// unknownVersionHandler responds 400 naming the version that was not recognized
+func unknownVersionHandler(headerParser func(r *http.Request) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		version := headerParser(r)
+		if version == "" {
+			http.Error(w, "Versão da API não informada", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Versão da API não suportada: '%s'", version), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,88 @@
+package valid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestVersionFromAccept(t *testing.T) {
+	tests := []struct {
+		accept  string
+		version string
+	}{
+		{"application/vnd.myapi.v2+json", "v2"},
+		{"application/vnd.myapi.v10+json; charset=utf-8", "v10"},
+		{"text/html, application/vnd.MyAPI.V3+json;q=0.9", "v3"},
+		{"application/vnd.myapi.v1", "v1"},
+		{"application/json", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("POST", "/users", nil)
+		if tt.accept != "" {
+			req.Header.Set("Accept", tt.accept)
+		}
+		if version := VersionFromAccept(req); version != tt.version {
+			t.Errorf("Accept '%s': esperava versão '%s', recebeu '%s'", tt.accept, tt.version, version)
+		}
+	}
+}
+
+func TestMiddlewareByVersion(t *testing.T) {
+	mv := NewMultiValidator()
+	if err := mv.AddFromString("v1", `{"type": "object", "required": ["name"]}`); err != nil {
+		t.Fatalf("erro ao adicionar validator: %v", err)
+	}
+	if err := mv.AddFromString("v2", `{"type": "object", "required": ["firstName", "lastName"]}`); err != nil {
+		t.Fatalf("erro ao adicionar validator: %v", err)
+	}
+
+	var called bool
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}
+	byAccept := mv.MiddlewareByVersion(VersionFromAccept)(handler)
+	byHeader := mv.MiddlewareByVersion(VersionFromHeader("X-Api-Version"), WithUnknownVersionHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotAcceptable)
+	}))(handler)
+
+	tests := []struct {
+		name       string
+		middleware http.HandlerFunc
+		method     string
+		header     string
+		value      string
+		body       string
+		status     int
+		called     bool
+	}{
+		{"accept v1 válido", byAccept, "POST", "Accept", "application/vnd.myapi.v1+json", `{"name": "Ana"}`, http.StatusOK, true},
+		{"accept v2 inválido", byAccept, "POST", "Accept", "application/vnd.myapi.v2+json", `{"name": "Ana"}`, http.StatusBadRequest, false},
+		{"accept v2 válido", byAccept, "POST", "Accept", "application/vnd.myapi.v2+json", `{"firstName": "Ana", "lastName": "Silva"}`, http.StatusOK, true},
+		{"accept desconhecido", byAccept, "POST", "Accept", "application/vnd.myapi.v9+json", `{"name": "Ana"}`, http.StatusBadRequest, false},
+		{"accept sem versão", byAccept, "POST", "Accept", "application/json", `{"name": "Ana"}`, http.StatusBadRequest, false},
+		{"método ignorado", byAccept, "GET", "Accept", "application/json", "", http.StatusOK, true},
+		{"header v2 válido", byHeader, "PUT", "X-Api-Version", "v2", `{"firstName": "Ana", "lastName": "Silva"}`, http.StatusOK, true},
+		{"header desconhecido", byHeader, "PUT", "X-Api-Version", "v3", `{}`, http.StatusNotAcceptable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			req := httptest.NewRequest(tt.method, "/users", strings.NewReader(tt.body))
+			req.Header.Set(tt.header, tt.value)
+			w := httptest.NewRecorder()
+			tt.middleware(w, req)
+
+			if w.Code != tt.status {
+				t.Errorf("esperava status %d, recebeu %d: %s", tt.status, w.Code, w.Body.String())
+			}
+			if called != tt.called {
+				t.Errorf("esperava handler chamado=%v, recebeu %v", tt.called, called)
+			}
+		})
+	}
+}
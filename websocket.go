@@ -0,0 +1,20 @@
+package valid
+
+// WebSocket frame types, matching the values used by RFC 6455 and gorilla/websocket
+const (
+	TextMessage   = 1
+	BinaryMessage = 2
+	CloseMessage  = 8
+	PingMessage   = 9
+	PongMessage   = 10
+)
+
+// ValidateMessage validates a WebSocket message against the schema.
+// Only text frames carry JSON, so any other frame type returns a nil result and a nil error.
+func (v *Validator) ValidateMessage(messageType int, data []byte) (*ValidationResult, error) {
+	if messageType != TextMessage {
+		return nil, nil
+	}
+
+	return v.ValidateBytes(data)
+}
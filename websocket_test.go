@@ -0,0 +1,33 @@
+package valid
+
+import "testing"
+
+func TestValidateMessage(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	result, err := validator.ValidateMessage(TextMessage, []byte(`{"name": "Ana", "email": "ana@test.com"}`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result == nil || !result.Valid {
+		t.Errorf("esperava mensagem de texto válida, recebeu %+v", result)
+	}
+
+	result, err = validator.ValidateMessage(TextMessage, []byte(`{"name": "A"}`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+	if result == nil || result.Valid {
+		t.Error("esperava mensagem de texto inválida")
+	}
+
+	for _, messageType := range []int{BinaryMessage, PingMessage, PongMessage, CloseMessage} {
+		result, err = validator.ValidateMessage(messageType, []byte{0x01, 0x02})
+		if err != nil || result != nil {
+			t.Errorf("esperava resultado nil para frame %d, recebeu %+v, %v", messageType, result, err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package valid
+
+import (
+	"bytes"
+	"errors"
+)
+
+// errWriterClosed is returned by writes to a closed ValidatingWriter
+var errWriterClosed = errors.New("escrita em ValidatingWriter já fechado")
+
+// ValidatingWriter is an io.WriteCloser that validates what is written to it,
+// so validation can be plugged into io.Copy pipelines. The whole output is one
+// JSON document: writes are buffered and the buffer is validated on Close,
+// whatever the chunk boundaries. Use ValidateMultiStream for streams holding
+// several documents. It is not safe for concurrent use.
+type ValidatingWriter struct {
+	validator *Validator
+	onResult  func(result *ValidationResult)
+	buf       bytes.Buffer
+	closed    bool
+}
+
+// NewValidatingWriter returns a writer that validates the document written to
+// it with v on Close, passing the result to onResult
+func NewValidatingWriter(v *Validator, onResult func(result *ValidationResult)) *ValidatingWriter {
+	return &ValidatingWriter{validator: v, onResult: onResult}
+}
+
+// Write buffers p, failing once the writer is closed
+func (w *ValidatingWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errWriterClosed
+	}
+	return w.buf.Write(p)
+}
+
+// Close validates the buffered document and passes the result to onResult.
+// It returns the error of ValidateBytes, such as for an empty document, in
+// which case onResult is not called. Closing again does nothing.
+func (w *ValidatingWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	result, err := w.validator.ValidateBytes(w.buf.Bytes())
+	w.buf = bytes.Buffer{}
+	if err != nil {
+		return err
+	}
+
+	if w.onResult != nil {
+		w.onResult(result)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package valid
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestValidatingWriter(t *testing.T) {
+	validator, err := NewFromString(testSchema)
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		document string
+		valid    bool
+	}{
+		{"válido", `{"name": "Ana", "email": "ana@x.com"}`, true},
+		{"inválido", `{"name": "A"}`, false},
+		{"JSON incompleto", `{"name": `, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var results []*ValidationResult
+			w := NewValidatingWriter(validator, func(result *ValidationResult) {
+				results = append(results, result)
+			})
+
+			// One byte per read, so the document arrives split across many writes
+			if _, err := io.Copy(w, iotest.OneByteReader(strings.NewReader(tt.document))); err != nil {
+				t.Fatalf("não esperava erro na cópia, mas recebeu: %v", err)
+			}
+			if len(results) != 0 {
+				t.Fatal("não esperava resultado antes de Close")
+			}
+
+			if err := w.Close(); err != nil {
+				t.Fatalf("não esperava erro no Close, mas recebeu: %v", err)
+			}
+			if len(results) != 1 || results[0].Valid != tt.valid {
+				t.Fatalf("esperava um resultado com Valid=%v, recebeu %+v", tt.valid, results)
+			}
+
+			if _, err := w.Write([]byte("{}")); !errors.Is(err, errWriterClosed) {
+				t.Errorf("esperava erro de escrita após Close, recebeu %v", err)
+			}
+			if err := w.Close(); err != nil || len(results) != 1 {
+				t.Errorf("o segundo Close não deveria validar de novo: %v, %d resultados", err, len(results))
+			}
+		})
+	}
+
+	called := false
+	w := NewValidatingWriter(validator, func(result *ValidationResult) { called = true })
+	if err := w.Close(); err == nil || called {
+		t.Errorf("esperava erro sem chamar onResult para documento vazio, recebeu %v, chamado=%v", err, called)
+	}
+}
@@ -0,0 +1,120 @@
+package valid
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ValidateXML converts an XML document to JSON and validates it against the
+// schema, which must describe the converted shape:
+//
+//   - the document is the content of the root element, whose name is dropped
+//   - attributes become "@name" properties, namespace declarations are skipped
+//   - child elements become properties named after their local name; an element
+//     repeated under the same parent becomes an array, one that appears once does not
+//   - an element with only text becomes that text, an empty one becomes ""
+//   - text mixed with attributes or child elements goes under "#text"
+//   - every value is a string; with the CoerceTypes option strings are converted
+//     to the number, integer or boolean type their schema expects
+//
+// For example <user id="7"><name>Ana</name><tag>a</tag><tag>b</tag></user>
+// becomes {"@id": "7", "name": "Ana", "tag": ["a", "b"]}.
+func (v *Validator) ValidateXML(xmlBytes []byte) (*ValidationResult, error) {
+	if len(xmlBytes) == 0 {
+		return nil, fmt.Errorf("dados XML não podem estar vazios")
+	}
+
+	document, err := xmlToJSON(xmlBytes)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao converter XML: %w", err)
+	}
+
+	jsonBytes, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao serializar dados para JSON: %w", err)
+	}
+
+	return v.ValidateBytes(jsonBytes)
+}
+
+// xmlToJSON converts the root element of an XML document to a JSON-compatible value
+func xmlToJSON(xmlBytes []byte) (interface{}, error) {
+	dec := xml.NewDecoder(bytes.NewReader(xmlBytes))
+
+	for {
+		token, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("documento sem elemento raiz")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return xmlElement(dec, start)
+		}
+	}
+}
+
+// xmlElement converts an element, whose start tag was just read, and its content
+func xmlElement(dec *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	object := make(map[string]interface{})
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		object["@"+attr.Name.Local] = attr.Value
+	}
+
+	var text strings.Builder
+	hasChildren := false
+
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch typed := token.(type) {
+		case xml.StartElement:
+			child, err := xmlElement(dec, typed)
+			if err != nil {
+				return nil, err
+			}
+			hasChildren = true
+			addXMLChild(object, typed.Name.Local, child)
+		case xml.CharData:
+			text.Write(typed)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(object) == 0 && !hasChildren {
+				return content, nil
+			}
+			if content != "" {
+				object["#text"] = content
+			}
+			return object, nil
+		}
+	}
+}
+
+// addXMLChild adds a child element to its parent, turning repeated names into arrays
+func addXMLChild(object map[string]interface{}, name string, child interface{}) {
+	existing, ok := object[name]
+	if !ok {
+		object[name] = child
+		return
+	}
+
+	if items, ok := existing.([]interface{}); ok {
+		object[name] = append(items, child)
+		return
+	}
+	object[name] = []interface{}{existing, child}
+}
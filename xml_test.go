@@ -0,0 +1,96 @@
+package valid
+
+import (
+	"reflect"
+	"testing"
+)
+
+const xmlSchema = `{
+	"type": "object",
+	"properties": {
+		"@id": {"type": "string", "pattern": "^[0-9]+$"},
+		"name": {"type": "string", "minLength": 2},
+		"age": {"type": "integer", "minimum": 18},
+		"tag": {"type": "array", "items": {"type": "string"}},
+		"note": {
+			"type": "object",
+			"properties": {"@lang": {"type": "string"}, "#text": {"type": "string"}},
+			"required": ["#text"]
+		}
+	},
+	"required": ["@id", "name"]
+}`
+
+func TestXMLToJSON(t *testing.T) {
+	document, err := xmlToJSON([]byte(`<?xml version="1.0"?>
+<user id="7" xmlns="urn:users">
+	<name>Ana</name>
+	<tag>a</tag>
+	<tag>b</tag>
+	<note lang="pt">olá</note>
+	<empty/>
+</user>`))
+	if err != nil {
+		t.Fatalf("não esperava erro, mas recebeu: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"@id":   "7",
+		"name":  "Ana",
+		"tag":   []interface{}{"a", "b"},
+		"note":  map[string]interface{}{"@lang": "pt", "#text": "olá"},
+		"empty": "",
+	}
+	if !reflect.DeepEqual(document, expected) {
+		t.Errorf("esperava %v, recebeu %v", expected, document)
+	}
+}
+
+func TestValidateXML(t *testing.T) {
+	validator, err := NewFromBytesWithOptions([]byte(xmlSchema), Options{CoerceTypes: true})
+	if err != nil {
+		t.Fatalf("erro ao criar validator: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		xmlData      string
+		expectValid  bool
+		expectError  bool
+		expectFields []string
+	}{
+		{name: "valid", xmlData: `<user id="7"><name>Ana</name><age>30</age><tag>a</tag><tag>b</tag></user>`, expectValid: true},
+		{name: "invalid attribute", xmlData: `<user id="x"><name>Ana</name></user>`, expectFields: []string{"@id"}},
+		{name: "coerced integer", xmlData: `<user id="7"><name>Ana</name><age>16</age></user>`, expectFields: []string{"age"}},
+		{name: "single element where array expected", xmlData: `<user id="7"><name>Ana</name><tag>a</tag></user>`, expectFields: []string{"tag"}},
+		{name: "malformed xml", xmlData: `<user id="7"><name>Ana</user>`, expectError: true},
+		{name: "no root element", xmlData: `<?xml version="1.0"?>`, expectError: true},
+		{name: "empty", xmlData: ``, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validator.ValidateXML([]byte(tt.xmlData))
+			if tt.expectError {
+				if err == nil {
+					t.Error("esperava erro, mas não recebeu")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("não esperava erro, mas recebeu: %v", err)
+			}
+			if result.Valid != tt.expectValid {
+				t.Fatalf("esperava valid=%v, mas recebeu valid=%v (%+v)", tt.expectValid, result.Valid, result.Errors)
+			}
+
+			var fields []string
+			for _, validationErr := range result.Errors {
+				fields = append(fields, validationErr.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.expectFields) {
+				t.Errorf("esperava erros em %v, recebeu %+v", tt.expectFields, result.Errors)
+			}
+		})
+	}
+}